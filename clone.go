@@ -0,0 +1,108 @@
+package twiml
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"reflect"
+
+	"github.com/go-playground/errors/v5"
+)
+
+// Clone returns a deep copy of the Response, which shares nothing with the original. It is
+// used to snapshot a template Response before customizing it, so that the template can be
+// cloned from many goroutines without racing.
+func (r *Response) Clone() *Response {
+	if r == nil {
+		return nil
+	}
+
+	c, _ := deepCopy(reflect.ValueOf(r)).Interface().(*Response)
+
+	return c
+}
+
+// deepCopy returns a copy of v with all its pointers, interfaces, slices and maps copied
+func deepCopy(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return reflect.Zero(v.Type())
+		}
+		c := reflect.New(v.Type().Elem())
+		c.Elem().Set(deepCopy(v.Elem()))
+
+		return c
+	case reflect.Interface:
+		if v.IsNil() {
+			return reflect.Zero(v.Type())
+		}
+		c := reflect.New(v.Type()).Elem()
+		c.Set(deepCopy(v.Elem()))
+
+		return c
+	case reflect.Slice:
+		if v.IsNil() {
+			return reflect.Zero(v.Type())
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(deepCopy(v.Index(i)))
+		}
+
+		return c
+	case reflect.Map:
+		if v.IsNil() {
+			return reflect.Zero(v.Type())
+		}
+		c := reflect.MakeMapWithSize(v.Type(), v.Len())
+		for iter := v.MapRange(); iter.Next(); {
+			c.SetMapIndex(iter.Key(), deepCopy(iter.Value()))
+		}
+
+		return c
+	case reflect.Struct:
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if c.Field(i).CanSet() {
+				c.Field(i).Set(deepCopy(v.Field(i)))
+			}
+		}
+
+		return c
+	default:
+		return v
+	}
+}
+
+// FrozenResponse is the immutable rendered form of a Response. Unlike a Response, it is
+// safe for concurrent use, so it can be rendered once and shared by every request.
+type FrozenResponse struct {
+	twiml []byte
+}
+
+// Freeze renders the Response into a FrozenResponse. Later changes to the Response do not
+// affect the FrozenResponse.
+func (r *Response) Freeze(ctx context.Context) (*FrozenResponse, error) {
+	res, err := r.Render(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "twiml.Response.Freeze()")
+	}
+
+	return &FrozenResponse{twiml: res}, nil
+}
+
+// Render returns a copy of the rendered twiml response
+func (f *FrozenResponse) Render(_ context.Context) ([]byte, error) {
+	return bytes.Clone(f.twiml), nil
+}
+
+// RenderTo writes the Rendered TwiML to the writer
+func (f *FrozenResponse) RenderTo(_ context.Context, w io.Writer) error {
+	if _, err := w.Write(f.twiml); err != nil {
+		return errors.Wrap(err, "io.Writer.Write()")
+	}
+
+	return nil
+}
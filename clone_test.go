@@ -0,0 +1,85 @@
+package twiml
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestResponse_Clone(t *testing.T) {
+	t.Parallel()
+
+	template := func() *Response {
+		return NewResponse().
+			Gather(NewGather().SetAction("/gather").SetProfanityFilter(false).Say(NewSay("Enter your pin"))).
+			Dial(NewDial().Conference(NewConference("room").SetWaitURL("/wait").AddAttr("jitterBufferSize", "small")))
+	}
+
+	r := template()
+	c := r.Clone()
+	if d := c.Diff(r); d != "" {
+		t.Fatalf("Response.Clone() %s", d)
+	}
+
+	gather, _ := c.Verbs[0].(*Gather)
+	gather.SetAction("/other").SetProfanityFilter(true).Say(NewSay("Goodbye"))
+	dial, _ := c.Verbs[1].(*Dial)
+	conference, _ := dial.Verbs[0].(*Conference)
+	conference.SetWaitURL("/other").AddAttr("jitterBufferSize", "large")
+	c.Hangup()
+
+	if d := r.Diff(template()); d != "" {
+		t.Errorf("Response.Clone() changes to the clone modified the original: %s", d)
+	}
+	if (*Response)(nil).Clone() != nil {
+		t.Errorf("Response.Clone() of nil Response != nil")
+	}
+}
+
+// TestResponse_Concurrent customizes a shared template and renders a shared frozen Response
+// from many goroutines, and is meant to be run with -race
+func TestResponse_Concurrent(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	template := NewResponse().Gather(NewGather().SetAction("/gather").Say(NewSay("Enter your pin")))
+	frozen, err := NewResponse().Say(NewSay("Goodbye")).Hangup().Freeze(ctx)
+	if err != nil {
+		t.Fatalf("Response.Freeze() error = %v", err)
+	}
+	want, err := frozen.Render(ctx)
+	if err != nil {
+		t.Fatalf("FrozenResponse.Render() error = %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			r := template.Clone()
+			gather, _ := r.Verbs[0].(*Gather)
+			gather.SetAction(fmt.Sprintf("/gather?caller=%d", i))
+			if _, err := r.Render(ctx); err != nil {
+				t.Errorf("Response.Render() error = %v", err)
+			}
+
+			buff := new(bytes.Buffer)
+			if err := frozen.RenderTo(ctx, buff); err != nil {
+				t.Errorf("FrozenResponse.RenderTo() error = %v", err)
+			}
+			if buff.String() != string(want) {
+				t.Errorf("FrozenResponse.RenderTo() = %v, want %v", buff.String(), string(want))
+			}
+		}()
+	}
+	wg.Wait()
+
+	if gather, _ := template.Verbs[0].(*Gather); gather.Action != "/gather" {
+		t.Errorf("template Gather.Action = %v, want %v", gather.Action, "/gather")
+	}
+}
@@ -0,0 +1,108 @@
+package twiml
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Equal reports whether the verb trees of r and other are semantically equal.
+// Verbs are compared field by field, so differences in rendering (attribute order,
+// whitespace) are ignored.
+func (r *Response) Equal(other *Response) bool {
+	return r.Diff(other) == ""
+}
+
+// Diff returns a human-readable description of the first difference between the
+// verb trees of r and other. An empty string is returned if they are equal.
+func (r *Response) Diff(other *Response) string {
+	switch {
+	case r == nil && other == nil:
+		return ""
+	case r == nil:
+		return "Response: <nil> != *twiml.Response"
+	case other == nil:
+		return "Response: *twiml.Response != <nil>"
+	}
+
+	return diffValue("Response.Verbs", reflect.ValueOf(r.Verbs), reflect.ValueOf(other.Verbs))
+}
+
+// diffValue walks a and b in parallel, returning a description of the first difference found
+func diffValue(path string, a, b reflect.Value) string {
+	if a.Kind() == reflect.Interface {
+		if a.IsNil() || b.IsNil() {
+			if a.IsNil() && b.IsNil() {
+				return ""
+			}
+
+			return fmt.Sprintf("%s: %s != %s", path, typeName(a), typeName(b))
+		}
+		a, b = a.Elem(), b.Elem()
+	}
+
+	if a.Type() != b.Type() {
+		return fmt.Sprintf("%s: %s != %s", path, a.Type(), b.Type())
+	}
+
+	switch a.Kind() {
+	case reflect.Ptr:
+		if a.IsNil() || b.IsNil() {
+			if a.IsNil() && b.IsNil() {
+				return ""
+			}
+
+			return fmt.Sprintf("%s: %s != %s", path, formatValue(a), formatValue(b))
+		}
+
+		return diffValue(path, a.Elem(), b.Elem())
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			f := a.Type().Field(i)
+			// XMLName is only set on decoded verbs, but the names of Extra attributes are compared
+			if !f.IsExported() || f.Name == "XMLName" {
+				continue
+			}
+			if d := diffValue(path+"."+f.Name, a.Field(i), b.Field(i)); d != "" {
+				return d
+			}
+		}
+
+		return ""
+	case reflect.Slice:
+		for i := 0; i < a.Len() && i < b.Len(); i++ {
+			if d := diffValue(fmt.Sprintf("%s[%d]", path, i), a.Index(i), b.Index(i)); d != "" {
+				return d
+			}
+		}
+		if a.Len() != b.Len() {
+			return fmt.Sprintf("%s: length %d != %d", path, a.Len(), b.Len())
+		}
+
+		return ""
+	default:
+		if a.Interface() != b.Interface() {
+			return fmt.Sprintf("%s: %s != %s", path, formatValue(a), formatValue(b))
+		}
+
+		return ""
+	}
+}
+
+func typeName(v reflect.Value) string {
+	if v.IsNil() {
+		return "<nil>"
+	}
+
+	return v.Elem().Type().String()
+}
+
+func formatValue(v reflect.Value) string {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return "<nil>"
+		}
+		v = v.Elem()
+	}
+
+	return fmt.Sprintf("%#v", v.Interface())
+}
@@ -0,0 +1,75 @@
+package twiml
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+func TestResponse_Diff(t *testing.T) {
+	t.Parallel()
+
+	base := func() *Response {
+		return NewResponse().
+			Dial(NewDial().
+				Number(NewNumber("810-730-3842"))).
+			Say(NewSay("Failed to connect"))
+	}
+
+	tests := []struct {
+		name  string
+		r     *Response
+		other *Response
+		want  string
+	}{
+		{name: "Equal", r: base(), other: base(), want: ""},
+		{
+			name: "Equal with XMLName set",
+			r:    base(),
+			other: &Response{
+				Verbs: []Verb{
+					&Dial{XMLName: xml.Name{Local: "Dial"}, Verbs: []Verb{&Number{XMLName: xml.Name{Local: "Number"}, Value: "810-730-3842"}}},
+					&Say{XMLName: xml.Name{Local: "Say"}, Value: "Failed to connect"},
+				},
+			},
+			want: "",
+		},
+		{name: "Both nil", r: nil, other: nil, want: ""},
+		{name: "Other nil", r: base(), other: nil, want: "Response: *twiml.Response != <nil>"},
+		{name: "Different value", r: base(), other: base().Say(NewSay("Goodbye")), want: "Response.Verbs: length 2 != 3"},
+		{
+			name:  "Different attribute",
+			r:     base(),
+			other: NewResponse().Dial(NewDial().Number(NewNumber("810-730-3842"))).Say(NewSay("Failed to connect").SetVoice(AliceVoice)),
+			want:  `Response.Verbs[1].Voice: "" != "alice"`,
+		},
+		{
+			name:  "Different nested value",
+			r:     base(),
+			other: NewResponse().Dial(NewDial().Number(NewNumber("810-730-3843"))).Say(NewSay("Failed to connect")),
+			want:  `Response.Verbs[0].Verbs[0].Value: "810-730-3842" != "810-730-3843"`,
+		},
+		{
+			name:  "Different extra attribute name",
+			r:     NewResponse().Dial(NewDial().AddAttr("ringTone", "us").Number(NewNumber("810-730-3842"))).Say(NewSay("Failed to connect")),
+			other: NewResponse().Dial(NewDial().AddAttr("ringtone", "us").Number(NewNumber("810-730-3842"))).Say(NewSay("Failed to connect")),
+			want:  `Response.Verbs[0].Extra[0].Name.Local: "ringTone" != "ringtone"`,
+		},
+		{
+			name:  "Different type",
+			r:     base(),
+			other: NewResponse().Dial(NewDial().Number(NewNumber("810-730-3842"))).Play(NewPlay("Failed to connect")),
+			want:  "Response.Verbs[1]: *twiml.Say != *twiml.Play",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tt.r.Diff(tt.other); got != tt.want {
+				t.Errorf("Response.Diff() = %v, want %v", got, tt.want)
+			}
+			if got := tt.r.Equal(tt.other); got != (tt.want == "") {
+				t.Errorf("Response.Equal() = %v, want %v", got, tt.want == "")
+			}
+		})
+	}
+}
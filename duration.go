@@ -0,0 +1,48 @@
+package twiml
+
+import (
+	"fmt"
+	"time"
+)
+
+// durationToSeconds converts d to the whole seconds TwiML attributes take, rounded to the
+// nearest second. Twilio requires a positive number of seconds, so a positive duration under
+// half a second is rounded up to one second, while a duration of zero or less is zero, which
+// leaves the attribute unset.
+func durationToSeconds(d time.Duration) uint {
+	switch {
+	case d <= 0:
+		return 0
+	case d < time.Second:
+		return 1
+	default:
+		return uint(d.Round(time.Second) / time.Second)
+	}
+}
+
+// maxCallSeconds is the four hours a call may last, beyond which no timeout can elapse
+const maxCallSeconds = 14400
+
+// subSecond returns d if it is a positive duration under a second, which durationToSeconds
+// rounds up to one second, so that Validate can report the duration given instead
+func subSecond(d time.Duration) time.Duration {
+	if d > 0 && d < time.Second {
+		return d
+	}
+
+	return 0
+}
+
+// validateSeconds checks that the seconds of the attribute name are between minimum and
+// maximum, reporting the sub-second duration they were rounded up from, if any. Zero leaves
+// the attribute unset, so it is not checked.
+func validateSeconds(name string, seconds uint, sub time.Duration, minimum, maximum uint) error {
+	switch {
+	case sub != 0 && seconds == 1:
+		return fmt.Errorf("%s %s is under a second, and must be between %d and %d seconds", name, sub, minimum, maximum)
+	case seconds != 0 && (seconds < minimum || seconds > maximum):
+		return fmt.Errorf("%s %d must be between %d and %d seconds", name, seconds, minimum, maximum)
+	}
+
+	return nil
+}
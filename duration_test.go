@@ -0,0 +1,57 @@
+package twiml
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_durationToSeconds(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		d    time.Duration
+		want uint
+	}{
+		{name: "Seconds", d: 30 * time.Second, want: 30},
+		{name: "Minutes", d: 2 * time.Minute, want: 120},
+		{name: "Round down", d: 10*time.Second + 499*time.Millisecond, want: 10},
+		{name: "Round up", d: 10*time.Second + 500*time.Millisecond, want: 11},
+		{name: "Under half a second", d: time.Millisecond, want: 1},
+		{name: "Zero", d: 0, want: 0},
+		{name: "Negative", d: -time.Minute, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := durationToSeconds(tt.d); got != tt.want {
+				t.Errorf("durationToSeconds() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetDuration(t *testing.T) {
+	t.Parallel()
+
+	d := 90*time.Second + 600*time.Millisecond
+
+	tests := []struct {
+		name string
+		got  uint
+	}{
+		{name: "Dial.SetTimeoutDuration", got: NewDial().SetTimeoutDuration(d).Timeout},
+		{name: "Gather.SetTimeoutDuration", got: NewGather().SetTimeoutDuration(d).Timeout},
+		{name: "Pause.SetLengthDuration", got: NewPause(0).SetLengthDuration(d).Length},
+		{name: "Record.SetTimeoutDuration", got: NewRecord().SetTimeoutDuration(d).Timeout},
+		{name: "Record.SetMaxLengthDuration", got: NewRecord().SetMaxLengthDuration(d).MaxLength},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if tt.got != 91 {
+				t.Errorf("%s() = %v, want %v", tt.name, tt.got, 91)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package twiml
+
+// EndsCall reports whether the Response ends the call, so that no further webhook for the
+// next TwiML will be requested. The verbs are followed in order to the first one which ends
+// the call (Hangup or Reject) or which hands control to another webhook (Redirect, Gather,
+// Record, Enqueue, or a Dial or Connect with an action). Since a Response may hand control on when it
+// is not certain to, such as a Gather which times out and falls through to the next verb,
+// EndsCall is conservative and returns false whenever a webhook might be requested. A
+// Response which simply runs out of verbs is also reported as false.
+//
+// Status callbacks are still sent for a call which has ended.
+func (r *Response) EndsCall() bool {
+	for _, v := range r.Verbs {
+		switch v := v.(type) {
+		case *Hangup, *Reject:
+			return true
+		case *Redirect, *Gather, *Record, *Enqueue, RawXML:
+			return false
+		case *Dial:
+			if v.Action != "" {
+				return false
+			}
+		case *Connect:
+			if v.Action != "" {
+				return false
+			}
+		}
+	}
+
+	return false
+}
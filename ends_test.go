@@ -0,0 +1,33 @@
+package twiml
+
+import "testing"
+
+func TestResponse_EndsCall(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		response *Response
+		want     bool
+	}{
+		{name: "Empty", response: NewResponse(), want: false},
+		{name: "Trailing Hangup", response: NewResponse().Say(NewSay("Goodbye")).Hangup(), want: true},
+		{name: "Reject", response: NewResponse().RejectBusy(), want: true},
+		{name: "Falls off the end", response: NewResponse().Say(NewSay("Goodbye")), want: false},
+		{name: "Redirect", response: NewResponse().Say(NewSay("Please hold")).Redirect(NewRedirect("/hold")), want: false},
+		{name: "Redirect before Hangup", response: NewResponse().Redirect(NewRedirect("/next")).Hangup(), want: false},
+		{name: "Gather before Hangup", response: NewResponse().Gather(NewGather().Say(NewSay("Enter your pin"))).Hangup(), want: false},
+		{name: "Voicemail", response: Voicemail("Leave a message", "/voicemail"), want: false},
+		{name: "Dial without action", response: NewResponse().Dial(NewDial().Numbers("+18005642365")).Hangup(), want: true},
+		{name: "Dial with action", response: NewResponse().Dial(NewDial().SetAction("/dial-status").Numbers("+18005642365")).Hangup(), want: false},
+		{name: "Raw", response: NewResponse().Raw([]byte("<Redirect>/next</Redirect>")).Hangup(), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tt.response.EndsCall(); got != tt.want {
+				t.Errorf("Response.EndsCall() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package twiml
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/go-playground/errors/v5"
+	"go.opencensus.io/trace"
+)
+
+// FlowStepParam is the query or form parameter which names the step of a Flow
+const FlowStepParam = "step"
+
+// FlowStepFunc returns the Response for a step of a call flow, given the values Twilio
+// sent to the webhook for that step
+type FlowStepFunc func(ctx context.Context, values RequestValues) (*Response, error)
+
+// Flow routes the webhook requests of a stateless call flow to the handler for each step.
+// Every webhook in the flow is served by the same endpoint, with the step named by the
+// FlowStepParam parameter of the URL (see StepURL).
+type Flow struct {
+	start string
+	steps map[string]FlowStepFunc
+}
+
+// NewFlow returns a Flow which begins at the start step
+func NewFlow(start string) *Flow {
+	return &Flow{start: start, steps: make(map[string]FlowStepFunc)}
+}
+
+// Step registers the handler for the named step
+func (f *Flow) Step(name string, fn FlowStepFunc) *Flow {
+	f.steps[name] = fn
+
+	return f
+}
+
+// Next returns the Response for a Request which has already been validated. The step is
+// read from the query string, then the form values, and is the start step if neither has it.
+func (f *Flow) Next(ctx context.Context, req *Request) (*Response, error) {
+	ctx, span := trace.StartSpan(ctx, "twiml.Flow.Next()")
+	defer span.End()
+
+	step := req.r.URL.Query().Get(FlowStepParam)
+	if step == "" {
+		step = req.Values.Get(FlowStepParam, f.start)
+	}
+	span.AddAttributes(trace.StringAttribute("step", step))
+
+	return f.Dispatch(ctx, step, req.Values)
+}
+
+// Dispatch returns the Response from the handler for the named step
+func (f *Flow) Dispatch(ctx context.Context, step string, values RequestValues) (*Response, error) {
+	fn, ok := f.steps[step]
+	if !ok {
+		return nil, errors.Wrap(fmt.Errorf("unknown step %q", step), "twiml.Flow.Dispatch()")
+	}
+
+	res, err := fn(ctx, values)
+	if err != nil {
+		return nil, errors.Wrapf(err, "twiml.Flow.Dispatch(): step %q", step)
+	}
+
+	return res, nil
+}
+
+// StepURL returns rawURL with the FlowStepParam set to step, for use as the action of a
+// verb or the URL of a Redirect
+func StepURL(rawURL, step string) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", errors.Wrap(err, "url.Parse()")
+	}
+
+	q := u.Query()
+	q.Set(FlowStepParam, step)
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
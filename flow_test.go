@@ -0,0 +1,117 @@
+package twiml
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func ExampleFlow() {
+	ctx := context.Background()
+
+	flow := NewFlow("menu").
+		Step("menu", func(_ context.Context, _ RequestValues) (*Response, error) {
+			action, err := StepURL("/voice", "choice")
+			if err != nil {
+				return nil, err
+			}
+
+			return NewResponse().
+				Gather(NewGather().
+					SetAction(action).
+					SetNumDigits(1).
+					Say(NewSay("Press 1 for sales"))), nil
+		}).
+		Step("choice", func(_ context.Context, values RequestValues) (*Response, error) {
+			if values["Digits"] == "1" {
+				return NewResponse().Dial(NewDial().Numbers("+18005642365")), nil
+			}
+
+			return NewResponse().Say(NewSay("Goodbye")).Hangup(), nil
+		})
+
+	for _, step := range []string{"menu", "choice"} {
+		res, err := flow.Dispatch(ctx, step, RequestValues{"Digits": "1"})
+		if err != nil {
+			fmt.Println(err)
+
+			return
+		}
+		twiml, err := res.Render(ctx)
+		if err != nil {
+			fmt.Println(err)
+
+			return
+		}
+		fmt.Println(string(twiml))
+	}
+
+	// Output:
+	// <?xml version="1.0" encoding="UTF-8"?>
+	// <Response>
+	//   <Gather action="/voice?step=choice" numDigits="1">
+	//     <Say>Press 1 for sales</Say>
+	//   </Gather>
+	// </Response>
+	// <?xml version="1.0" encoding="UTF-8"?>
+	// <Response>
+	//   <Dial>
+	//     <Number>+18005642365</Number>
+	//   </Dial>
+	// </Response>
+}
+
+func TestFlow_Next(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	step := func(name string) FlowStepFunc {
+		return func(_ context.Context, _ RequestValues) (*Response, error) {
+			return NewResponse().Say(NewSay(name)), nil
+		}
+	}
+	flow := NewFlow("start").Step("start", step("start")).Step("menu", step("menu"))
+
+	tests := []struct {
+		name    string
+		target  string
+		form    url.Values
+		want    string
+		wantErr bool
+	}{
+		{name: "Start", target: "/voice", want: "start"},
+		{name: "Query", target: "/voice?step=menu", want: "menu"},
+		{name: "Form", target: "/voice", form: url.Values{"step": {"menu"}}, want: "menu"},
+		{name: "Unknown", target: "/voice?step=other", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := httptest.NewRequest(http.MethodPost, tt.target, strings.NewReader(tt.form.Encode()))
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			if err := SignRequest(r, "https://example.com"+tt.target, "token"); err != nil {
+				t.Fatalf("SignRequest() error = %v", err)
+			}
+			req := NewRequest("https://example.com", r)
+			if err := req.ValidatePost(ctx, "token"); err != nil {
+				t.Fatalf("Request.ValidatePost() error = %v", err)
+			}
+
+			got, err := flow.Next(ctx, req)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Flow.Next() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if want := NewResponse().Say(NewSay(tt.want)); !got.Equal(want) {
+				t.Errorf("Flow.Next() = %v", got.Diff(want))
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package twiml
+
+import "fmt"
+
+// HoldMusic returns a Response which plays musicURL and then redirects to pollURL, which is
+// the standard TwiML pattern for holding a call. While the call should stay on hold pollURL
+// returns HoldMusic again, so the music loops and the hold is re-evaluated on each pass. The
+// call is taken off hold by updating it through the REST API with new TwiML or a new URL,
+// which interrupts the Play, or by having pollURL return the next step of the call.
+func HoldMusic(musicURL, pollURL string) *Response {
+	return NewResponse().
+		Play(NewPlay(musicURL)).
+		Redirect(NewRedirect(pollURL).SetMethod(Post))
+}
+
+// VoicemailOption overrides a default of the Voicemail flow
+type VoicemailOption func(v *voicemail)
+
+type voicemail struct {
+	maxLength  uint
+	playBeep   bool
+	transcribe bool
+	goodbye    string
+}
+
+// VoicemailMaxLength sets the longest message which can be left, in seconds. The default is 120.
+func VoicemailMaxLength(seconds uint) VoicemailOption {
+	return func(v *voicemail) {
+		v.maxLength = seconds
+	}
+}
+
+// VoicemailPlayBeep sets whether a beep is played before recording starts. The default is true.
+func VoicemailPlayBeep(playBeep bool) VoicemailOption {
+	return func(v *voicemail) {
+		v.playBeep = playBeep
+	}
+}
+
+// VoicemailTranscribe sets whether the message is transcribed. The default is true.
+func VoicemailTranscribe(transcribe bool) VoicemailOption {
+	return func(v *voicemail) {
+		v.transcribe = transcribe
+	}
+}
+
+// VoicemailGoodbye sets what is said after the message is recorded. The default is "Goodbye".
+func VoicemailGoodbye(goodbye string) VoicemailOption {
+	return func(v *voicemail) {
+		v.goodbye = goodbye
+	}
+}
+
+// Voicemail returns a Response which says promptText, records a message which is sent to
+// actionURL, and then says goodbye and hangs up. The message is recorded for up to two
+// minutes after a beep, and is transcribed. The defaults can be overridden with options, and
+// the returned Response can be changed like any other.
+//
+// The goodbye is only reached if the caller stays silent until the Record times out, as
+// Twilio requests actionURL for the next TwiML once a message is recorded.
+func Voicemail(promptText, actionURL string, opts ...VoicemailOption) *Response {
+	v := &voicemail{maxLength: 120, playBeep: true, transcribe: true, goodbye: "Goodbye"}
+	for _, opt := range opts {
+		opt(v)
+	}
+
+	return NewResponse().
+		Say(NewSay(promptText)).
+		Record(NewRecord().
+			SetAction(actionURL).
+			SetMethod(Post).
+			SetMaxLength(v.maxLength).
+			SetPlayBeep(v.playBeep).
+			SetTranscribe(v.transcribe)).
+		Say(NewSay(v.goodbye)).
+		Hangup()
+}
+
+// EnqueueWaitLoop returns the TwiML for the waitUrl of an Enqueue, given the values Twilio
+// sent to waitURL. The caller is told their position in the queue, hears musicURL once, and
+// is then redirected back to waitURL, so the position is announced afresh on each pass. The
+// announcement is left out when the position is not known.
+func EnqueueWaitLoop(musicURL, waitURL string, values RequestValues) *Response {
+	r := NewResponse()
+	if pos, err := values.QueuePosition(); err == nil && pos > 0 {
+		r.Say(NewSay(fmt.Sprintf("You are number %d in the queue.", pos)))
+	}
+
+	return r.
+		Play(NewPlay(musicURL)).
+		Redirect(NewRedirect(waitURL).SetMethod(Post))
+}
+
+// PlayWithFallback returns a Response which plays url followed by the fallback Say. TwiML has
+// no way to act on a Play failing, as Twilio skips a media URL it can not fetch and moves on
+// to the next verb, so the fallback is always spoken. This ensures the caller hears the
+// message one way or another, but the fallback should be phrased to make sense after the
+// media, such as a short summary of it. Speaking the fallback only when the media fails
+// requires a webhook, such as a Redirect to a handler which checks the media first.
+func PlayWithFallback(url string, fallback *Say) *Response {
+	return NewResponse().
+		Play(NewPlay(url)).
+		Say(fallback)
+}
@@ -0,0 +1,150 @@
+package twiml
+
+import (
+	"context"
+	"encoding/xml"
+	"testing"
+)
+
+func TestHoldMusic(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	header := xml.Header[:len(xml.Header)-1]
+
+	want := header + `
+<Response>
+  <Play>https://example.com/hold.mp3</Play>
+  <Redirect method="POST">https://example.com/hold</Redirect>
+</Response>`
+
+	got, err := HoldMusic("https://example.com/hold.mp3", "https://example.com/hold").Render(ctx)
+	if err != nil {
+		t.Fatalf("Response.Render() error = %v", err)
+	}
+	if string(got) != want {
+		t.Errorf("HoldMusic() = %v, want %v", string(got), want)
+	}
+}
+
+func TestVoicemail(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	header := xml.Header[:len(xml.Header)-1]
+
+	tests := []struct {
+		name     string
+		response *Response
+		want     string
+	}{
+		{
+			name:     "Defaults",
+			response: Voicemail("Please leave a message", "https://example.com/voicemail"),
+			want: header + `
+<Response>
+  <Say>Please leave a message</Say>
+  <Record action="https://example.com/voicemail" method="POST" maxLength="120" playBeep="true" transcribe="true"></Record>
+  <Say>Goodbye</Say>
+  <Hangup></Hangup>
+</Response>`,
+		},
+		{
+			name: "Options",
+			response: Voicemail("Please leave a message", "https://example.com/voicemail",
+				VoicemailMaxLength(30), VoicemailPlayBeep(false), VoicemailTranscribe(false), VoicemailGoodbye("Thank you")),
+			want: header + `
+<Response>
+  <Say>Please leave a message</Say>
+  <Record action="https://example.com/voicemail" method="POST" maxLength="30" playBeep="false"></Record>
+  <Say>Thank you</Say>
+  <Hangup></Hangup>
+</Response>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if err := tt.response.Validate(); err != nil {
+				t.Errorf("Response.Validate() error = %v", err)
+			}
+			got, err := tt.response.Render(ctx)
+			if err != nil {
+				t.Fatalf("Response.Render() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Voicemail() = %v, want %v", string(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestEnqueueWaitLoop(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	header := xml.Header[:len(xml.Header)-1]
+
+	tests := []struct {
+		name   string
+		values RequestValues
+		want   string
+	}{
+		{
+			name:   "Position",
+			values: RequestValues{"QueuePosition": "3", "QueueTime": "45"},
+			want: header + `
+<Response>
+  <Say>You are number 3 in the queue.</Say>
+  <Play>https://example.com/hold.mp3</Play>
+  <Redirect method="POST">https://example.com/wait</Redirect>
+</Response>`,
+		},
+		{
+			name:   "Unknown position",
+			values: RequestValues{},
+			want: header + `
+<Response>
+  <Play>https://example.com/hold.mp3</Play>
+  <Redirect method="POST">https://example.com/wait</Redirect>
+</Response>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := EnqueueWaitLoop("https://example.com/hold.mp3", "https://example.com/wait", tt.values).Render(ctx)
+			if err != nil {
+				t.Fatalf("Response.Render() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("EnqueueWaitLoop() = %v, want %v", string(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestPlayWithFallback(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	header := xml.Header[:len(xml.Header)-1]
+
+	want := header + `
+<Response>
+  <Play>https://example.com/closed.mp3</Play>
+  <Say voice="alice">Our office is closed.</Say>
+</Response>`
+
+	got, err := PlayWithFallback("https://example.com/closed.mp3", AliceVoice.Say("Our office is closed.")).Render(ctx)
+	if err != nil {
+		t.Fatalf("Response.Render() error = %v", err)
+	}
+	if string(got) != want {
+		t.Errorf("PlayWithFallback() = %v, want %v", string(got), want)
+	}
+}
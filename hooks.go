@@ -0,0 +1,37 @@
+package twiml
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// renderHook and validationHook hold the hooks set by SetRenderHook and SetValidationHook
+var (
+	renderHook     atomic.Pointer[func(ctx context.Context, rendered []byte)]
+	validationHook atomic.Pointer[func(ctx context.Context, err error)]
+)
+
+// SetRenderHook sets a hook which is called with the TwiML of every Response rendered with
+// Render, RenderTo or RenderWith, for logging or metrics. A nil hook removes it, which is the
+// default. The hook is called from the goroutine rendering the Response, so it must be safe
+// for concurrent use, and must not modify rendered.
+func SetRenderHook(hook func(ctx context.Context, rendered []byte)) {
+	if hook == nil {
+		renderHook.Store(nil)
+
+		return
+	}
+	renderHook.Store(&hook)
+}
+
+// SetValidationHook sets a hook which is called with the error of every Response which fails
+// Validate. A nil hook removes it, which is the default. Validate does not take a context, so
+// the hook is called with context.Background(). The hook must be safe for concurrent use.
+func SetValidationHook(hook func(ctx context.Context, err error)) {
+	if hook == nil {
+		validationHook.Store(nil)
+
+		return
+	}
+	validationHook.Store(&hook)
+}
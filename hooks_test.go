@@ -0,0 +1,64 @@
+package twiml
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+)
+
+type hookTestKey struct{}
+
+func TestHooks(t *testing.T) {
+	t.Parallel()
+
+	// Other tests render and validate in parallel, so only calls made by this test are recorded
+	var (
+		mu       sync.Mutex
+		rendered []string
+		failed   []error
+	)
+	SetRenderHook(func(ctx context.Context, twiml []byte) {
+		if ctx.Value(hookTestKey{}) != nil {
+			mu.Lock()
+			defer mu.Unlock()
+			rendered = append(rendered, string(twiml))
+		}
+	})
+	SetValidationHook(func(_ context.Context, err error) {
+		if strings.Contains(err.Error(), "hook-test") {
+			mu.Lock()
+			defer mu.Unlock()
+			failed = append(failed, err)
+		}
+	})
+	t.Cleanup(func() {
+		SetRenderHook(nil)
+		SetValidationHook(nil)
+	})
+
+	ctx := context.WithValue(context.Background(), hookTestKey{}, true)
+	want, err := NewResponse().Say(NewSay("Hello")).Render(ctx)
+	if err != nil {
+		t.Fatalf("Response.Render() error = %v", err)
+	}
+	if err := NewResponse().Redirect(NewRedirect("/next")).Validate(); err != nil {
+		t.Fatalf("Response.Validate() error = %v", err)
+	}
+	if err := NewResponse().Dial(NewDial().Conference(NewConference("room").SetCoach("hook-test"))).Validate(); err == nil {
+		t.Fatalf("Response.Validate() error = nil, want error")
+	}
+	if _, err := NewValidatingResponse().Dial(NewDial().Conference(NewConference("room").SetCoach("hook-test"))).RenderWith(ctx, WithValidation()); err == nil {
+		t.Fatalf("Response.RenderWith() error = nil, want error")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(rendered) != 1 || rendered[0] != string(want) {
+		t.Errorf("render hook called with %v, want %v", rendered, []string{string(want)})
+	}
+	// RenderWith validates a validating Response once, not again in Render
+	if len(failed) != 2 {
+		t.Errorf("validation hook called with %v, want 2 errors", failed)
+	}
+}
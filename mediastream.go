@@ -0,0 +1,96 @@
+package twiml
+
+import (
+	"encoding/json"
+
+	"github.com/go-playground/errors/v5"
+)
+
+// StreamEvent is an enum for the event of a Media Stream message
+type StreamEvent string
+
+const (
+	// ConnectedEvent is the first message sent once the websocket is connected
+	ConnectedEvent StreamEvent = "connected"
+
+	// StartEvent describes the stream, and is sent once, before any media
+	StartEvent StreamEvent = "start"
+
+	// MediaEvent carries a chunk of audio
+	MediaEvent StreamEvent = "media"
+
+	// StopEvent is sent when the stream has stopped or the call has ended
+	StopEvent StreamEvent = "stop"
+
+	// MarkEvent is sent to Twilio after media on a bidirectional stream, and is sent back by
+	// Twilio once that media has finished playing
+	MarkEvent StreamEvent = "mark"
+)
+
+// StreamMessage is a message sent by Twilio over the websocket of a Media Stream, as
+// started by the Stream verb. Only the payload matching the Event is set.
+type StreamMessage struct {
+	Event          StreamEvent   `json:"event"`
+	SequenceNumber string        `json:"sequenceNumber,omitempty"`
+	StreamSid      string        `json:"streamSid,omitempty"`
+	Start          *StartPayload `json:"start,omitempty"`
+	Media          *MediaPayload `json:"media,omitempty"`
+	Stop           *StopPayload  `json:"stop,omitempty"`
+	Mark           *MarkPayload  `json:"mark,omitempty"`
+}
+
+// StartPayload is the payload of a start message
+type StartPayload struct {
+	StreamSid        string            `json:"streamSid"`
+	AccountSid       string            `json:"accountSid"`
+	CallSid          string            `json:"callSid"`
+	Tracks           []string          `json:"tracks"`
+	CustomParameters map[string]string `json:"customParameters"`
+	MediaFormat      MediaFormat       `json:"mediaFormat"`
+}
+
+// MediaFormat describes the encoding of the audio in media messages
+type MediaFormat struct {
+	Encoding   string `json:"encoding"`
+	SampleRate int    `json:"sampleRate"`
+	Channels   int    `json:"channels"`
+}
+
+// MediaPayload is the payload of a media message. Payload is the base64 encoded audio.
+type MediaPayload struct {
+	Track     string `json:"track"`
+	Chunk     string `json:"chunk"`
+	Timestamp string `json:"timestamp"`
+	Payload   string `json:"payload"`
+}
+
+// StopPayload is the payload of a stop message
+type StopPayload struct {
+	AccountSid string `json:"accountSid"`
+	CallSid    string `json:"callSid"`
+}
+
+// MarkPayload is the payload of a mark message
+type MarkPayload struct {
+	Name string `json:"name"`
+}
+
+// DecodeStreamMessage decodes a message received over the websocket of a Media Stream
+func DecodeStreamMessage(data []byte) (*StreamMessage, error) {
+	msg := &StreamMessage{}
+	if err := json.Unmarshal(data, msg); err != nil {
+		return nil, errors.Wrap(err, "twiml.DecodeStreamMessage(): json.Unmarshal()")
+	}
+
+	return msg, nil
+}
+
+// EncodeMark returns the mark message to send over the websocket of a bidirectional stream.
+// Twilio requires the streamSid of the stream, which is in the start message. Once the media
+// sent before the mark has played, Twilio sends back a mark message with the same name.
+func EncodeMark(streamSid, name string) []byte {
+	// Marshaling a struct of strings can not fail
+	data, _ := json.Marshal(&StreamMessage{Event: MarkEvent, StreamSid: streamSid, Mark: &MarkPayload{Name: name}})
+
+	return data
+}
@@ -0,0 +1,208 @@
+package twiml
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"reflect"
+	"testing"
+)
+
+func TestConnect_Stream(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	header := xml.Header[:len(xml.Header)-1]
+
+	params := map[string]string{"caller": "+18005642365", "language": "en-US"}
+	stream := NewStream().SetURL("wss://example.com/audio").SetStatusCallback("/stream-status").
+		Parameters(
+			NewParameter().SetName("caller").SetValue(params["caller"]),
+			NewParameter().SetName("language").SetValue(params["language"]),
+		)
+
+	got, err := NewResponse().Connect(NewConnect().Stream(stream)).Render(ctx)
+	if err != nil {
+		t.Fatalf("Response.Render() error = %v", err)
+	}
+	want := header + `
+<Response>
+  <Connect>
+    <Stream url="wss://example.com/audio" statusCallback="/stream-status">
+      <Parameter name="caller" value="+18005642365"></Parameter>
+      <Parameter name="language" value="en-US"></Parameter>
+    </Stream>
+  </Connect>
+</Response>`
+	if string(got) != want {
+		t.Fatalf("Response.Render() = %v, want %v", string(got), want)
+	}
+
+	// Twilio delivers the Parameters of the Stream in the start message
+	customParameters := make(map[string]string)
+	for _, v := range stream.Verbs {
+		if p, ok := v.(*Parameter); ok {
+			customParameters[p.Name] = p.Value
+		}
+	}
+	frame, err := json.Marshal(map[string]interface{}{
+		"event":          "start",
+		"sequenceNumber": "1",
+		"streamSid":      "MZ18ad3ab5a668481ce02b83e7395059f0",
+		"start": map[string]interface{}{
+			"streamSid":        "MZ18ad3ab5a668481ce02b83e7395059f0",
+			"accountSid":       "AC123",
+			"callSid":          "CA123",
+			"tracks":           []string{"inbound"},
+			"customParameters": customParameters,
+			"mediaFormat":      map[string]interface{}{"encoding": "audio/x-mulaw", "sampleRate": 8000, "channels": 1},
+		},
+	})
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	msg, err := DecodeStreamMessage(frame)
+	if err != nil {
+		t.Fatalf("DecodeStreamMessage() error = %v", err)
+	}
+	if msg.Event != StartEvent || msg.Start == nil {
+		t.Fatalf("DecodeStreamMessage() = %+v, want start message", msg)
+	}
+	if !reflect.DeepEqual(msg.Start.CustomParameters, params) {
+		t.Errorf("StartPayload.CustomParameters = %v, want %v", msg.Start.CustomParameters, params)
+	}
+	if msg.Start.MediaFormat.SampleRate != 8000 {
+		t.Errorf("MediaFormat.SampleRate = %v, want %v", msg.Start.MediaFormat.SampleRate, 8000)
+	}
+}
+
+func TestStream_AddCustomParameter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	want := map[string]string{"callerId": "+18005642365", "language": "en-US", "ticket": "T-1 & T-2"}
+	stream := NewStream().SetURL("wss://example.com/audio")
+	for _, name := range []string{"callerId", "language", "ticket"} {
+		stream.AddCustomParameter(name, want[name])
+	}
+
+	twiml, err := NewResponse().Connect(NewConnect().Stream(stream)).Render(ctx)
+	if err != nil {
+		t.Fatalf("Response.Render() error = %v", err)
+	}
+
+	// Simulate Twilio reading the Parameters from the TwiML into the start message
+	var parsed struct {
+		Params []struct {
+			Name  string `xml:"name,attr"`
+			Value string `xml:"value,attr"`
+		} `xml:"Connect>Stream>Parameter"`
+	}
+	if err := xml.Unmarshal(twiml, &parsed); err != nil {
+		t.Fatalf("xml.Unmarshal() error = %v", err)
+	}
+	customParameters := make(map[string]string)
+	for _, p := range parsed.Params {
+		customParameters[p.Name] = p.Value
+	}
+	frame, err := json.Marshal(&StreamMessage{
+		Event:     StartEvent,
+		StreamSid: "MZ123",
+		Start:     &StartPayload{StreamSid: "MZ123", CallSid: "CA123", CustomParameters: customParameters},
+	})
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	msg, err := DecodeStreamMessage(frame)
+	if err != nil {
+		t.Fatalf("DecodeStreamMessage() error = %v", err)
+	}
+	if msg.Start == nil || !reflect.DeepEqual(msg.Start.CustomParameters, want) {
+		t.Errorf("StartPayload.CustomParameters = %+v, want %v", msg.Start, want)
+	}
+}
+
+func TestConnect_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		connect *Connect
+		wantErr bool
+	}{
+		{name: "Stream with url", connect: NewConnect().Stream(NewStream().SetURL("wss://example.com/audio"))},
+		{name: "Stream without url", connect: NewConnect().Stream(NewStream().SetName("audio")), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if err := NewResponse().Connect(tt.connect).Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Response.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDecodeStreamMessage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		data    string
+		want    *StreamMessage
+		wantErr bool
+	}{
+		{
+			name: "Media",
+			data: `{"event":"media","sequenceNumber":"3","media":{"track":"inbound","chunk":"1","timestamp":"5","payload":"no+JhoaJjpzSHxAKBgYJ"},"streamSid":"MZ123"}`,
+			want: &StreamMessage{
+				Event: MediaEvent, SequenceNumber: "3", StreamSid: "MZ123",
+				Media: &MediaPayload{Track: "inbound", Chunk: "1", Timestamp: "5", Payload: "no+JhoaJjpzSHxAKBgYJ"},
+			},
+		},
+		{
+			name: "Stop",
+			data: `{"event":"stop","sequenceNumber":"5","stop":{"accountSid":"AC123","callSid":"CA123"},"streamSid":"MZ123"}`,
+			want: &StreamMessage{Event: StopEvent, SequenceNumber: "5", StreamSid: "MZ123", Stop: &StopPayload{AccountSid: "AC123", CallSid: "CA123"}},
+		},
+		{
+			name: "Mark",
+			data: `{"event":"mark","sequenceNumber":"4","streamSid":"MZ123","mark":{"name":"greeting"}}`,
+			want: &StreamMessage{Event: MarkEvent, SequenceNumber: "4", StreamSid: "MZ123", Mark: &MarkPayload{Name: "greeting"}},
+		},
+		{name: "Invalid", data: `{"event":`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := DecodeStreamMessage([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DecodeStreamMessage() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DecodeStreamMessage() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEncodeMark(t *testing.T) {
+	t.Parallel()
+
+	got := EncodeMark("MZ123", "greeting")
+	if want := `{"event":"mark","streamSid":"MZ123","mark":{"name":"greeting"}}`; string(got) != want {
+		t.Errorf("EncodeMark() = %s, want %s", got, want)
+	}
+
+	msg, err := DecodeStreamMessage(got)
+	if err != nil {
+		t.Fatalf("DecodeStreamMessage() error = %v", err)
+	}
+	if msg.Event != MarkEvent || msg.StreamSid != "MZ123" || msg.Mark == nil || msg.Mark.Name != "greeting" {
+		t.Errorf("DecodeStreamMessage() = %+v, want mark greeting", msg)
+	}
+}
@@ -0,0 +1,49 @@
+package twiml
+
+import (
+	"fmt"
+
+	"github.com/go-playground/errors/v5"
+)
+
+// DTMFMenu routes the digits pressed in response to a Gather to the handler for that menu
+// option. It is independent of the request, so the digits are usually passed in from
+// RequestValues.Digits.
+type DTMFMenu struct {
+	options map[string]func() error
+	def     func() error
+}
+
+// NewDTMFMenu returns a DTMFMenu with no options
+func NewDTMFMenu() *DTMFMenu {
+	return &DTMFMenu{options: make(map[string]func() error)}
+}
+
+// On registers the handler for the menu option selected by digits
+func (m *DTMFMenu) On(digits string, fn func() error) *DTMFMenu {
+	m.options[digits] = fn
+
+	return m
+}
+
+// Default registers the handler for digits which do not select any option, including
+// when no digits were pressed
+func (m *DTMFMenu) Default(fn func() error) *DTMFMenu {
+	m.def = fn
+
+	return m
+}
+
+// Dispatch calls the handler for the option selected by digits, or the Default handler if
+// none is selected. An error is returned if there is no handler to call.
+func (m *DTMFMenu) Dispatch(digits string) error {
+	fn, ok := m.options[digits]
+	if !ok {
+		fn = m.def
+	}
+	if fn == nil {
+		return errors.Wrap(fmt.Errorf("no option for digits %q", digits), "twiml.DTMFMenu.Dispatch()")
+	}
+
+	return fn()
+}
@@ -0,0 +1,81 @@
+package twiml
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleDTMFMenu() {
+	values := RequestValues{"Digits": "2"}
+
+	var res *Response
+	err := NewDTMFMenu().
+		On("1", func() error {
+			res = NewResponse().Dial(NewDial().Numbers("+18005642365"))
+
+			return nil
+		}).
+		On("2", func() error {
+			res = NewResponse().Dial(NewDial().Numbers("+18005642366"))
+
+			return nil
+		}).
+		Default(func() error {
+			res = NewResponse().Say(NewSay("Sorry, that is not an option")).Redirect(NewRedirect("/menu"))
+
+			return nil
+		}).
+		Dispatch(values.Digits())
+	if err != nil {
+		fmt.Println(err)
+
+		return
+	}
+
+	fmt.Println(res.Verbs[0].(*Dial).Verbs[0].(*Number).Value)
+
+	// Output:
+	// +18005642366
+}
+
+func TestDTMFMenu_Dispatch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		digits     string
+		hasDefault bool
+		want       string
+		wantErr    bool
+	}{
+		{name: "Option", digits: "1", hasDefault: true, want: "sales"},
+		{name: "Multiple digits", digits: "42", hasDefault: true, want: "operator"},
+		{name: "Default", digits: "9", hasDefault: true, want: "default"},
+		{name: "No digits", digits: "", hasDefault: true, want: "default"},
+		{name: "No default", digits: "9", hasDefault: false, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var got string
+			route := func(name string) func() error {
+				return func() error {
+					got = name
+
+					return nil
+				}
+			}
+			menu := NewDTMFMenu().On("1", route("sales")).On("42", route("operator"))
+			if tt.hasDefault {
+				menu.Default(route("default"))
+			}
+
+			if err := menu.Dispatch(tt.digits); (err != nil) != tt.wantErr {
+				t.Errorf("DTMFMenu.Dispatch() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("DTMFMenu.Dispatch() routed to %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
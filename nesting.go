@@ -0,0 +1,54 @@
+package twiml
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// allowedChildren lists the verbs and nouns which each TwiML element may contain. Elements
+// which are not listed may not contain any. Add new verbs here as they are modeled.
+var allowedChildren = map[string][]string{
+	"Response":          {"Connect", "Dial", "Enqueue", "Gather", "Hangup", "Pause", "Play", "Record", "Redirect", "Refer", "Reject", "Say", "Start"},
+	"Gather":            {"Pause", "Play", "Say"},
+	"Dial":              {"Application", "Client", "Conference", "Number", "Sip"},
+	"Start":             {"Stream"},
+	"Connect":           {"ConversationRelay", "Stream"},
+	"Stream":            {"Parameter"},
+	"Application":       {"Parameter"},
+	"Client":            {"Identity", "Parameter"},
+	"Refer":             {"Sip"},
+	"ConversationRelay": {"Language", "Parameter"},
+}
+
+// validateNesting checks that parent may contain each of verbs, and that each of verbs
+// contains only what it may, naming the parent and child of each violation
+func validateNesting(parent string, verbs []Verb) []error {
+	var errs []error
+	for _, v := range verbs {
+		if _, ok := v.(RawXML); ok {
+			continue
+		}
+
+		name := verbName(v)
+		if !slices.Contains(allowedChildren[parent], name) {
+			errs = append(errs, fmt.Errorf("twiml.Response.Validate(): %s may not contain %s", parent, name))
+		}
+		errs = append(errs, validateNesting(name, nestedVerbs(v))...)
+	}
+
+	return errs
+}
+
+// verbName returns the element name of v, which is the name of its type
+func verbName(v interface{}) string {
+	t := reflect.TypeOf(v)
+	if t == nil {
+		return "<nil>"
+	}
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	return t.Name()
+}
@@ -0,0 +1,61 @@
+package twiml
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestResponse_ValidateNesting(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		response *Response
+		wantErr  string
+	}{
+		{
+			name: "Valid",
+			response: NewResponse().
+				Gather(NewGather().SetAction("/gather").Say(NewSay("Enter your pin")).Pause(1)).
+				Start(NewStart().Stream(NewStream().SetURL("wss://example.com/audio").Parameter(NewParameter().SetName("a").SetValue("b")))).
+				Dial(NewDial().Client(NewClient().Identity("alice"))).
+				Raw([]byte("<Hangup/>")),
+		},
+		{
+			name:     "Dial in Gather",
+			response: NewResponse().Gather(&Gather{Action: "/gather", Verbs: []Verb{NewDial().Numbers("+18005642365")}}),
+			wantErr:  "Gather may not contain Dial",
+		},
+		{
+			name:     "Noun in Response",
+			response: &Response{Verbs: []Verb{NewNumber("+18005642365")}},
+			wantErr:  "Response may not contain Number",
+		},
+		{
+			name:     "Say in Stream",
+			response: NewResponse().Connect(NewConnect().Stream(&Stream{URL: "wss://example.com/audio", Verbs: []Verb{NewSay("Hello")}})),
+			wantErr:  "Stream may not contain Say",
+		},
+		{
+			name:     "Start in Gather",
+			response: NewResponse().Gather(&Gather{Action: "/gather", Verbs: []Verb{NewSay("Hello"), &Start{}}}),
+			wantErr:  "Gather may not contain Start",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := tt.response.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Response.Validate() error = %v, want nil", err)
+				}
+
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Response.Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
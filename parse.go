@@ -0,0 +1,212 @@
+package twiml
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/go-playground/errors/v5"
+)
+
+// Parse parses a TwiML document into a Response, so that TwiML which was written by hand or
+// rendered from a template can be validated or inspected. Each element must be a verb or
+// noun modeled by this package, though nesting is not checked until Validate.
+func Parse(twiml []byte) (*Response, error) {
+	d := xml.NewDecoder(bytes.NewReader(twiml))
+	for {
+		tok, err := d.Token()
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("twiml.Parse(): no Response element")
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "xml.Decoder.Token()")
+		}
+
+		start, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		if start.Name.Local != "Response" {
+			return nil, fmt.Errorf("twiml.Parse(): root element is %s, not Response", start.Name.Local)
+		}
+
+		verbs, err := parseVerbs(d)
+		if err != nil {
+			return nil, errors.Wrap(err, "twiml.Parse()")
+		}
+
+		return &Response{Verbs: verbs}, nil
+	}
+}
+
+// ValidateBytes parses a TwiML document and validates the Response, returning the error
+// from Parse, or all the problems found by Validate joined together. It is intended for
+// tooling which checks TwiML which was not built with this package.
+func ValidateBytes(twiml []byte) error {
+	r, err := Parse(twiml)
+	if err != nil {
+		return err
+	}
+
+	return r.Validate()
+}
+
+// parseVerbs parses each element read from d as a verb, until the end of the enclosing
+// element or of the input. Elements named in fields are skipped, as they were decoded into
+// fields of the enclosing verb.
+func parseVerbs(d *xml.Decoder, fields ...string) ([]Verb, error) {
+	var verbs []Verb
+	for {
+		tok, err := d.Token()
+		if errors.Is(err, io.EOF) {
+			return verbs, nil
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "xml.Decoder.Token()")
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if slices.Contains(fields, t.Name.Local) {
+				if err := d.Skip(); err != nil {
+					return nil, errors.Wrap(err, "xml.Decoder.Skip()")
+				}
+
+				continue
+			}
+			v, err := parseVerb(d, t)
+			if err != nil {
+				return nil, err
+			}
+			verbs = append(verbs, v)
+		case xml.EndElement:
+			return verbs, nil
+		}
+	}
+}
+
+// parseVerb parses the element begun by start as the verb of the same name, along with any
+// verbs nested within it
+func parseVerb(d *xml.Decoder, start xml.StartElement) (Verb, error) {
+	v := newVerb(start.Name.Local)
+	if v == nil {
+		return nil, fmt.Errorf("unknown element %s", start.Name.Local)
+	}
+
+	var inner struct {
+		XML []byte `xml:",innerxml"`
+	}
+	if err := d.DecodeElement(&inner, &start); err != nil {
+		return nil, errors.Wrap(err, "xml.Decoder.DecodeElement()")
+	}
+
+	// The element is decoded again into the verb, which ignores the nested verbs, as they
+	// can only be told apart by name
+	var el bytes.Buffer
+	el.WriteString("<" + start.Name.Local)
+	for _, attr := range start.Attr {
+		el.WriteString(" " + attr.Name.Local + `="`)
+		if err := xml.EscapeText(&el, []byte(attr.Value)); err != nil {
+			return nil, errors.Wrap(err, "xml.EscapeText()")
+		}
+		el.WriteString(`"`)
+	}
+	el.WriteString(">")
+	el.Write(inner.XML)
+	el.WriteString("</" + start.Name.Local + ">")
+	if err := xml.Unmarshal(el.Bytes(), v); err != nil {
+		return nil, errors.Wrap(err, "xml.Unmarshal()")
+	}
+
+	if say, ok := v.(*Say); ok {
+		// Both hold the text of the Say, so only SSML is kept if there are SSML elements
+		if strings.Contains(say.SSML, "<") {
+			say.Value = ""
+		} else {
+			say.SSML = ""
+		}
+	}
+
+	if f := reflect.ValueOf(v).Elem().FieldByName("Verbs"); f.IsValid() {
+		verbs, err := parseVerbs(xml.NewDecoder(bytes.NewReader(inner.XML)), elementFields(v)...)
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s", start.Name.Local)
+		}
+		f.Set(reflect.ValueOf(verbs))
+	}
+
+	return v, nil
+}
+
+// elementFields returns the names of the child elements which are decoded into fields of v,
+// such as the ApplicationSid of an Application, rather than being nested verbs
+func elementFields(v Verb) []string {
+	var names []string
+	t := reflect.TypeOf(v).Elem()
+	for i := range t.NumField() {
+		name, opts, _ := strings.Cut(t.Field(i).Tag.Get("xml"), ",")
+		if name != "" && name != "-" && (opts == "" || opts == "omitempty") && t.Field(i).Name != "XMLName" {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+// newVerb returns a new verb of the TwiML element name, or nil if it is not modeled
+func newVerb(name string) Verb {
+	switch name {
+	case "Application":
+		return &Application{}
+	case "Client":
+		return &Client{}
+	case "Conference":
+		return &Conference{}
+	case "Connect":
+		return &Connect{}
+	case "ConversationRelay":
+		return &ConversationRelay{}
+	case "Dial":
+		return &Dial{}
+	case "Enqueue":
+		return &Enqueue{}
+	case "Gather":
+		return &Gather{}
+	case "Hangup":
+		return &Hangup{}
+	case "Identity":
+		return &Identity{}
+	case "Language":
+		return &Language{}
+	case "Number":
+		return &Number{}
+	case "Parameter":
+		return &Parameter{}
+	case "Pause":
+		return &Pause{}
+	case "Play":
+		return &Play{}
+	case "Record":
+		return &Record{}
+	case "Redirect":
+		return &Redirect{}
+	case "Refer":
+		return &Refer{}
+	case "Reject":
+		return &Reject{}
+	case "Say":
+		return &Say{}
+	case "Sip":
+		return &Sip{}
+	case "Start":
+		return &Start{}
+	case "Stream":
+		return &Stream{}
+	}
+
+	return nil
+}
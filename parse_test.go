@@ -0,0 +1,97 @@
+package twiml
+
+import (
+	"context"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	r := NewResponse().
+		Say(NewSay("Hello & welcome").SetVoice(AliceVoice).SetLoop(2)).
+		Say(NewSay("").SetSSML(`<prosody rate="slow">Goodbye</prosody>`)).
+		Gather(NewGather().SetAction("/gather").SetNumDigits(4).Say(NewSay("Enter your pin")).Pause(1)).
+		Dial(NewDial().SetTimeout(20).Number(NewNumber("+18005550100").SetStatusCallback("https://example.com/status")).Conference(NewConference("room").DisableWaitURL())).
+		Dial(NewDial().Application(NewApplication("AP123").Parameter(NewParameter().SetName("agent").SetValue("42")))).
+		Connect(NewConnect().Stream(NewStream().SetURL("wss://example.com/stream").Parameter(NewParameter().SetName("name").SetValue("value")))).
+		Redirect(NewRedirect("https://example.com/next")).
+		Hangup()
+	r.Verbs[0].(*Say).AddAttr("custom", "1")
+
+	b, err := r.Render(ctx)
+	if err != nil {
+		t.Fatalf("Response.Render() error = %v", err)
+	}
+
+	got, err := Parse(b)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if d := got.Diff(r); d != "" {
+		t.Errorf("Parse() differs from the rendered Response: %s", d)
+	}
+}
+
+func TestValidateBytes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		twiml   string
+		wantErr bool
+	}{
+		{
+			name:  "Valid",
+			twiml: `<?xml version="1.0" encoding="UTF-8"?><Response><Say>Hello</Say><Hangup/></Response>`,
+		},
+		{
+			name:  "Valid nested",
+			twiml: `<Response><Gather action="/gather" numDigits="4"><Say>Enter your pin</Say></Gather></Response>`,
+		},
+		{
+			name:  "Application",
+			twiml: `<Response><Dial><Application><ApplicationSid>AP123</ApplicationSid><Parameter name="agent" value="42"/></Application></Dial></Response>`,
+		},
+		{
+			name:    "Malformed",
+			twiml:   `<Response><Say>Hello</Response>`,
+			wantErr: true,
+		},
+		{
+			name:    "Not TwiML",
+			twiml:   `<html><body>Hello</body></html>`,
+			wantErr: true,
+		},
+		{
+			name:    "Empty",
+			twiml:   ``,
+			wantErr: true,
+		},
+		{
+			name:    "Unknown verb",
+			twiml:   `<Response><Shout>Hello</Shout></Response>`,
+			wantErr: true,
+		},
+		{
+			name:    "Invalid nesting",
+			twiml:   `<Response><Gather><Dial>+18005550100</Dial></Gather></Response>`,
+			wantErr: true,
+		},
+		{
+			name:    "Invalid attribute",
+			twiml:   `<Response><Reject reason="closed"/></Response>`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if err := ValidateBytes([]byte(tt.twiml)); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateBytes() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
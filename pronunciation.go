@@ -0,0 +1,125 @@
+package twiml
+
+import (
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Pronunciations is a dictionary of substitutions applied to the text of a Say, mapping a
+// term to how it should be spoken, such as "SQL" to "sequel". Terms are case sensitive, and
+// are matched where they are not part of a longer word, that is where they are not preceded
+// or followed by a letter, digit or underscore. So terms which begin or end with
+// punctuation, such as "C++" or ".NET", are matched too. An application typically builds its
+// dictionary once with NewPronunciations and uses it for every prompt. It is safe for
+// concurrent use.
+type Pronunciations struct {
+	subs  map[string]string
+	terms []string
+	re    *regexp.Regexp
+}
+
+// NewPronunciations returns a dictionary of the substitutions in subs. An empty term is
+// ignored, as it can not be matched.
+func NewPronunciations(subs map[string]string) *Pronunciations {
+	p := &Pronunciations{subs: maps.Clone(subs)}
+	delete(p.subs, "")
+	if len(p.subs) == 0 {
+		return p
+	}
+
+	// Longer terms are tried first, so that "SQL Server" is matched before "SQL"
+	p.terms = slices.Collect(maps.Keys(p.subs))
+	slices.SortFunc(p.terms, func(a, b string) int { return len(b) - len(a) })
+
+	quoted := make([]string, len(p.terms))
+	for i, term := range p.terms {
+		quoted[i] = regexp.QuoteMeta(term)
+	}
+	p.re = regexp.MustCompile(strings.Join(quoted, "|"))
+
+	return p
+}
+
+// Say returns a Say verb for msg with the substitutions applied
+func (p *Pronunciations) Say(msg string) *Say {
+	s := NewSay(msg)
+	s.Value = p.replace(s.Value)
+
+	return s
+}
+
+// replace returns text with each term which is not part of a longer word substituted
+func (p *Pronunciations) replace(text string) string {
+	if p.re == nil || text == "" {
+		return text
+	}
+
+	// RE2 has no lookaround, so the pattern only finds where a term may start, and the
+	// terms are then checked at that position for a boundary on either side
+	var b strings.Builder
+	pos := 0
+	for pos < len(text) {
+		loc := p.re.FindStringIndex(text[pos:])
+		if loc == nil {
+			break
+		}
+		start := pos + loc[0]
+		if term := p.termAt(text, start); term != "" {
+			b.WriteString(text[pos:start])
+			b.WriteString(p.subs[term])
+			pos = start + len(term)
+
+			continue
+		}
+
+		// Only part of a longer word matched, so the search resumes after its first character
+		_, size := utf8.DecodeRuneInString(text[start:])
+		b.WriteString(text[pos : start+size])
+		pos = start + size
+	}
+	b.WriteString(text[pos:])
+
+	return b.String()
+}
+
+// termAt returns the longest term at start of text which is not part of a longer word, or
+// an empty string if there is none
+func (p *Pronunciations) termAt(text string, start int) string {
+	if before, _ := utf8.DecodeLastRuneInString(text[:start]); start > 0 && isWordRune(before) {
+		return ""
+	}
+	for _, term := range p.terms {
+		if !strings.HasPrefix(text[start:], term) {
+			continue
+		}
+		if after, _ := utf8.DecodeRuneInString(text[start+len(term):]); start+len(term) < len(text) && isWordRune(after) {
+			continue
+		}
+
+		return term
+	}
+
+	return ""
+}
+
+// isWordRune reports whether r is a letter, digit or underscore
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// ApplySubstitutions rewrites each term of subs in the Say value to its substitution,
+// matching terms as Pronunciations does. A term is not rewritten where it is part of a
+// longer word, so "SQL" is rewritten in "SQL database" but not in "SQLite". A dictionary
+// used for many prompts is better built once with NewPronunciations.
+func (s *Say) ApplySubstitutions(subs map[string]string) *Say {
+	if len(subs) == 0 || s.Value == "" {
+		return s
+	}
+	s.Value = NewPronunciations(subs).replace(s.Value)
+
+	return s
+}
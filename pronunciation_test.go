@@ -0,0 +1,59 @@
+package twiml
+
+import "testing"
+
+func TestSay_ApplySubstitutions(t *testing.T) {
+	t.Parallel()
+
+	subs := map[string]string{
+		"SQL": "sequel", "SQL Server": "sequel server", "IVR": "I V R", "Acme.io": "acme I O",
+		"C++": "C plus plus", ".NET": "dot net", "AT&T": "A T and T",
+	}
+	p := NewPronunciations(subs)
+
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{name: "Whole word", value: "Your SQL database is ready", want: "Your sequel database is ready"},
+		{name: "Part of a word", value: "Your SQLite database is ready", want: "Your SQLite database is ready"},
+		{name: "Longest term first", value: "Connect to SQL Server", want: "Connect to sequel server"},
+		{name: "Shorter term when the longest is part of a word", value: "Try SQL Serverless", want: "Try sequel Serverless"},
+		{name: "Punctuation", value: "Welcome to the IVR, powered by Acme.io.", want: "Welcome to the I V R, powered by acme I O."},
+		{name: "Case sensitive", value: "sql", want: "sql"},
+		{name: "Repeated", value: "SQL and SQL", want: "sequel and sequel"},
+		{name: "Adjacent", value: "SQL/SQL", want: "sequel/sequel"},
+		{name: "Ends with punctuation", value: "Hire a C++ developer", want: "Hire a C plus plus developer"},
+		{name: "Begins with punctuation", value: "Built on .NET.", want: "Built on dot net."},
+		{name: "Punctuation within", value: "Call AT&T now", want: "Call A T and T now"},
+		{name: "Punctuation term part of a word", value: "ASP.NET and C++20", want: "ASP.NET and C++20"},
+		{name: "Part of a non-ASCII word", value: "ÉSQL", want: "ÉSQL"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := NewSay(tt.value).ApplySubstitutions(subs).Value; got != tt.want {
+				t.Errorf("Say.ApplySubstitutions() = %v, want %v", got, tt.want)
+			}
+			if got := p.Say(tt.value).Value; got != tt.want {
+				t.Errorf("Pronunciations.Say() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSay_ApplySubstitutions_EmptyTerm(t *testing.T) {
+	t.Parallel()
+
+	subs := map[string]string{"": "oops"}
+	if got := NewSay("Your SQL database").ApplySubstitutions(subs).Value; got != "Your SQL database" {
+		t.Errorf("Say.ApplySubstitutions() = %v, want the value unchanged", got)
+	}
+	if got := NewPronunciations(subs).Say("Your SQL database").Value; got != "Your SQL database" {
+		t.Errorf("Pronunciations.Say() = %v, want the value unchanged", got)
+	}
+	if got := NewPronunciations(map[string]string{"": "oops", "SQL": "sequel"}).Say("Your SQL database").Value; got != "Your sequel database" {
+		t.Errorf("Pronunciations.Say() = %v, want only SQL substituted", got)
+	}
+}
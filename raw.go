@@ -0,0 +1,74 @@
+package twiml
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/go-playground/errors/v5"
+)
+
+// bufferPool holds the buffers used for rendering, to save allocating a new one per Response
+var bufferPool = sync.Pool{
+	New: func() any { return new(bytes.Buffer) },
+}
+
+// RawXML is a pre-rendered TwiML snippet, such as one loaded from a template or cache, which
+// is spliced into a Response without being parsed into verbs.
+//
+// The bytes are not checked or escaped in any way, so the caller owns their correctness.
+// Never build a RawXML from untrusted input, as it can inject arbitrary TwiML into the call.
+type RawXML []byte
+
+// MarshalXML encodes the RawXML. A RawXML added directly to a Response is written to the
+// output verbatim by Render and Stream. Nested within another verb, where the encoder's
+// writer is not available, its tokens are re-encoded instead, which preserves the markup
+// but not its original formatting.
+func (x RawXML) MarshalXML(e *xml.Encoder, _ xml.StartElement) error {
+	dec := xml.NewDecoder(bytes.NewReader(x))
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return errors.Wrap(err, "xml.Decoder.Token()")
+		}
+		if cd, ok := tok.(xml.CharData); ok && strings.TrimSpace(string(cd)) == "" {
+			continue
+		}
+		if err := e.EncodeToken(xml.CopyToken(tok)); err != nil {
+			return errors.Wrap(err, "xml.Encoder.EncodeToken()")
+		}
+	}
+
+	return nil
+}
+
+// Precompile renders a verb once, returning it as RawXML which can be added to any number of
+// Responses with Response.Raw. This is useful for hot paths which return the same prompts over
+// and over, as the precompiled verb is written out without being encoded again. The verb is
+// rendered with the indentation of a verb added directly to a Response.
+func Precompile(v Verb) (RawXML, error) {
+	buff, ok := bufferPool.Get().(*bytes.Buffer)
+	if !ok {
+		buff = new(bytes.Buffer)
+	}
+	defer putBuffer(buff)
+
+	enc := xml.NewEncoder(buff)
+	enc.Indent("  ", "  ")
+	if err := enc.Encode(v); err != nil {
+		return nil, errors.Wrap(err, "twiml.Precompile(): xml.Encoder.Encode()")
+	}
+
+	return RawXML(bytes.Clone(bytes.TrimPrefix(buff.Bytes(), []byte("  ")))), nil
+}
+
+// putBuffer resets buff and returns it to the bufferPool
+func putBuffer(buff *bytes.Buffer) {
+	buff.Reset()
+	bufferPool.Put(buff)
+}
@@ -0,0 +1,131 @@
+package twiml
+
+import (
+	"context"
+	"encoding/xml"
+	"testing"
+)
+
+func TestResponse_Raw(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	header := xml.Header[:len(xml.Header)-1]
+
+	tests := []struct {
+		name     string
+		response *Response
+		want     string
+	}{
+		{
+			name:     "Verbatim",
+			response: NewResponse().Say(NewSay("Hello")).Raw([]byte(`<Play loop="2">https://example.com/hold.mp3</Play>`)).Hangup(),
+			want: header + `
+<Response>
+  <Say>Hello</Say>
+  <Play loop="2">https://example.com/hold.mp3</Play>
+  <Hangup></Hangup>
+</Response>`,
+		},
+		{
+			name:     "Only child",
+			response: NewResponse().Raw([]byte(`<Hangup/>`)),
+			want: header + `
+<Response>
+  <Hangup/>
+</Response>`,
+		},
+		{
+			name: "Nested",
+			response: NewResponse().Gather(&Gather{
+				Action: "/gather",
+				Verbs:  []Verb{RawXML("\n<Say>Enter your pin</Say>\n")},
+			}),
+			want: header + `
+<Response>
+  <Gather action="/gather">
+    <Say>Enter your pin</Say>
+  </Gather>
+</Response>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := tt.response.Render(ctx)
+			if err != nil {
+				t.Fatalf("Response.Render() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Response.Render() = %v, want %v", string(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestPrecompile(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		verb Verb
+	}{
+		{name: "Say", verb: NewSay("Please hold").SetVoice(AliceVoice)},
+		{name: "Gather", verb: NewGather().SetAction("/gather").SetNumDigits(1).Say(NewSay("Press 1 for sales"))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			raw, err := Precompile(tt.verb)
+			if err != nil {
+				t.Fatalf("Precompile() error = %v", err)
+			}
+			got, err := NewResponse().Raw(raw).Hangup().Render(ctx)
+			if err != nil {
+				t.Fatalf("Response.Render() error = %v", err)
+			}
+			want, err := (&Response{Verbs: []Verb{tt.verb}}).Hangup().Render(ctx)
+			if err != nil {
+				t.Fatalf("Response.Render() error = %v", err)
+			}
+			if string(got) != string(want) {
+				t.Errorf("Response.Render() = %v, want %v", string(got), string(want))
+			}
+		})
+	}
+}
+
+func BenchmarkRender(b *testing.B) {
+	ctx := context.Background()
+
+	prompt := func() *Gather {
+		return NewGather().SetAction("/gather").SetNumDigits(1).
+			Say(NewSay("Thank you for calling. Press 1 for sales, or 2 for support.").SetVoice(AliceVoice))
+	}
+
+	b.Run("Fresh", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := NewResponse().Gather(prompt()).Hangup().Render(ctx); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("Precompiled", func(b *testing.B) {
+		raw, err := Precompile(prompt())
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			if _, err := NewResponse().Raw(raw).Hangup().Render(ctx); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,291 @@
+package twiml
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"regexp"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/go-playground/errors/v5"
+	"go.opencensus.io/trace"
+)
+
+// RenderOption configures how RenderWith renders a Response
+type RenderOption func(o *renderOptions)
+
+type renderOptions struct {
+	sortAttributes bool
+	selfClosing    bool
+	validate       bool
+	apiVersion     string
+	declaration    string
+}
+
+// APIVersion2010 is the 2010-04-01 version of the Twilio API, which is the version TwiML is
+// rendered for by default
+const APIVersion2010 = "2010-04-01"
+
+// WithSortedAttributes sorts the attributes added with AddAttr alphabetically, so that the
+// output is stable for snapshot tests and cache keys no matter the order they were added in.
+// Modeled attributes keep their declaration order, and are always rendered first.
+func WithSortedAttributes() RenderOption {
+	return func(o *renderOptions) {
+		o.sortAttributes = true
+	}
+}
+
+// WithSelfClosingEmpty renders elements without any content as self-closing tags, such as
+// <Pause length="1"/> instead of <Pause length="1"></Pause>, for more compact output. Twilio
+// treats both forms the same.
+func WithSelfClosingEmpty() RenderOption {
+	return func(o *renderOptions) {
+		o.selfClosing = true
+	}
+}
+
+// WithValidation validates the Response before it is rendered, returning the error from
+// Validate instead of TwiML which is invalid. See NewValidatingResponse to always validate.
+func WithValidation() RenderOption {
+	return func(o *renderOptions) {
+		o.validate = true
+	}
+}
+
+// WithAPIVersion renders the Response for the given version of the Twilio API, as sent in the
+// ApiVersion of a request. The attributes and verbs modeled by this package all behave as
+// documented for 2010-04-01, the only version Twilio still serves TwiML for, so there are
+// no version-sensitive attributes yet and any other version is an error. As Twilio versions
+// the behavior of an attribute, its rules are selected here by version.
+func WithAPIVersion(v string) RenderOption {
+	return func(o *renderOptions) {
+		o.apiVersion = v
+	}
+}
+
+// WithXMLDeclaration renders the Response with decl as its XML declaration in place of
+// xml.Header, for strict parsers which expect a declaration without an encoding, such as
+// <?xml version="1.0"?>, or with it in a different case. The TwiML is always encoded as UTF-8,
+// so decl must be a well-formed XML declaration with no encoding, or an encoding of UTF-8 in
+// any case, or RenderWith returns an error.
+func WithXMLDeclaration(decl string) RenderOption {
+	return func(o *renderOptions) {
+		o.declaration = decl
+	}
+}
+
+// RenderWith returns the rendered twiml response, rendered with the given options. The
+// Response is not modified.
+func (r *Response) RenderWith(ctx context.Context, opts ...RenderOption) ([]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "twiml.Response.RenderWith()")
+	defer span.End()
+
+	o := &renderOptions{apiVersion: APIVersion2010}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	if o.apiVersion != APIVersion2010 {
+		return nil, fmt.Errorf("twiml.Response.RenderWith(): unsupported API version %q", o.apiVersion)
+	}
+
+	if o.declaration != "" && !validXMLDeclaration(o.declaration) {
+		return nil, fmt.Errorf("twiml.Response.RenderWith(): invalid XML declaration %q", o.declaration)
+	}
+
+	if o.validate {
+		if err := r.Validate(); err != nil {
+			return nil, err
+		}
+
+		// A Response from NewValidatingResponse is not validated again by Render
+		unvalidated := *r
+		unvalidated.validate = false
+		r = &unvalidated
+	}
+
+	if o.sortAttributes {
+		r = r.Clone()
+		walkVerbs(r.Verbs, sortExtra)
+	}
+
+	res, err := r.Render(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if o.selfClosing {
+		res = selfCloseEmpty(res)
+	}
+
+	if o.declaration != "" {
+		res = append([]byte(o.declaration+"\n"), bytes.TrimPrefix(res, []byte(xml.Header))...)
+	}
+
+	return res, nil
+}
+
+// RenderTemplate returns the rendered twiml response, with each {{name}} placeholder in its
+// URL attributes, such as action and Redirect, replaced by data[name]. Values are substituted
+// in a single pass, as is, and escaped as XML when rendered, so they may be complete URLs.
+// Placeholders in other attributes and text are left as is. An error is returned for a
+// placeholder missing from data. The Response is not modified.
+func (r *Response) RenderTemplate(ctx context.Context, data map[string]string) ([]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "twiml.Response.RenderTemplate()")
+	defer span.End()
+
+	r = r.Clone()
+	var errs []error
+	walkVerbs(r.Verbs, func(v Verb) {
+		for _, attr := range urlAttrs(v) {
+			value, err := expandPlaceholders(*attr.value, data)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("twiml.Response.RenderTemplate(): %s %q: %w", attr.name, *attr.value, err))
+
+				continue
+			}
+			*attr.value = value
+		}
+	})
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return r.Render(ctx)
+}
+
+// RenderAll renders each of responses concurrently, returning the results in the same order.
+// At most GOMAXPROCS responses are rendered at once. The first error stops the responses
+// not yet started from being rendered, and is returned without any results.
+func RenderAll(ctx context.Context, responses []*Response) ([][]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "twiml.RenderAll()")
+	defer span.End()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	res := make([][]byte, len(responses))
+	sem := make(chan struct{}, runtime.GOMAXPROCS(0))
+	for i, r := range responses {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			b, err := r.Render(ctx)
+			if err != nil {
+				once.Do(func() {
+					firstErr = errors.Wrapf(err, "twiml.RenderAll(): responses[%d]", i)
+					cancel()
+				})
+
+				return
+			}
+			res[i] = b
+		}()
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, errors.Wrap(err, "twiml.RenderAll()")
+	}
+
+	return res, nil
+}
+
+// expandPlaceholders returns s with each {{name}} replaced by data[name]. Substituted values
+// are not expanded again.
+func expandPlaceholders(s string, data map[string]string) (string, error) {
+	var b strings.Builder
+	for {
+		i := strings.Index(s, "{{")
+		if i < 0 {
+			break
+		}
+		j := strings.Index(s[i:], "}}")
+		if j < 0 {
+			break
+		}
+
+		name := s[i+2 : i+j]
+		value, ok := data[name]
+		if !ok {
+			return "", fmt.Errorf("no value for placeholder {{%s}}", name)
+		}
+		b.WriteString(s[:i])
+		b.WriteString(value)
+		s = s[i+j+2:]
+	}
+	b.WriteString(s)
+
+	return b.String(), nil
+}
+
+// xmlDeclarationPattern matches a well-formed XML declaration for a UTF-8 document
+var xmlDeclarationPattern = regexp.MustCompile(`^<\?xml\s+version\s*=\s*(?:"1\.\d+"|'1\.\d+')` +
+	`(?:\s+encoding\s*=\s*(?:"(?i:utf-8)"|'(?i:utf-8)'))?` +
+	`(?:\s+standalone\s*=\s*(?:"(?:yes|no)"|'(?:yes|no)'))?\s*\?>$`)
+
+// validXMLDeclaration reports whether decl is a well-formed XML declaration for a UTF-8
+// document
+func validXMLDeclaration(decl string) bool {
+	return xmlDeclarationPattern.MatchString(decl)
+}
+
+// emptyElementPattern matches an element with no content, written as a start tag immediately
+// followed by an end tag. RE2 has no backreferences, so selfCloseEmpty matches the end tag to
+// the start tag.
+var emptyElementPattern = regexp.MustCompile(`<([A-Za-z][\w.:-]*)((?:\s+[^\s<>="]+="[^"<>]*")*)></([A-Za-z][\w.:-]*)>`)
+
+// selfCloseEmpty rewrites each element in twiml which has no content, written as a start
+// tag immediately followed by its end tag, as a self-closing tag
+func selfCloseEmpty(twiml []byte) []byte {
+	return emptyElementPattern.ReplaceAllFunc(twiml, func(m []byte) []byte {
+		sub := emptyElementPattern.FindSubmatch(m)
+		if !bytes.Equal(sub[1], sub[3]) {
+			return m
+		}
+
+		return append(append(append([]byte("<"), sub[1]...), sub[2]...), "/>"...)
+	})
+}
+
+// sortExtra sorts the Extra attributes of v by name
+func sortExtra(v Verb) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Struct {
+		return
+	}
+
+	extra, ok := rv.Elem().FieldByName("Extra").Interface().([]xml.Attr)
+	if !ok {
+		return
+	}
+	slices.SortStableFunc(extra, func(a, b xml.Attr) int {
+		return cmp.Or(cmp.Compare(a.Name.Space, b.Name.Space), cmp.Compare(a.Name.Local, b.Name.Local))
+	})
+}
@@ -0,0 +1,465 @@
+package twiml
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestResponse_RenderWith_SortedAttributes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	header := xml.Header[:len(xml.Header)-1]
+
+	build := func(attrs ...string) *Response {
+		say := NewSay("Hello").SetVoice(AliceVoice)
+		for _, name := range attrs {
+			say.AddAttr(name, "1")
+		}
+
+		return NewResponse().Say(say)
+	}
+	want := header + `
+<Response>
+  <Say voice="alice" a="1" b="1" c="1">Hello</Say>
+</Response>`
+
+	for _, order := range [][]string{{"a", "b", "c"}, {"c", "b", "a"}, {"b", "c", "a"}} {
+		r := build(order...)
+		got, err := r.RenderWith(ctx, WithSortedAttributes())
+		if err != nil {
+			t.Fatalf("Response.RenderWith() error = %v", err)
+		}
+		if string(got) != want {
+			t.Errorf("Response.RenderWith(%v) = %v, want %v", order, string(got), want)
+		}
+		if d := r.Diff(build(order...)); d != "" {
+			t.Errorf("Response.RenderWith() modified the Response: %s", d)
+		}
+	}
+
+	got, err := build("c", "a").RenderWith(ctx)
+	if err != nil {
+		t.Fatalf("Response.RenderWith() error = %v", err)
+	}
+	if want := header + `
+<Response>
+  <Say voice="alice" c="1" a="1">Hello</Say>
+</Response>`; string(got) != want {
+		t.Errorf("Response.RenderWith() = %v, want %v", string(got), want)
+	}
+}
+
+func TestResponse_RenderWith_SelfClosingEmpty(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	header := xml.Header[:len(xml.Header)-1]
+
+	r := NewResponse().
+		Gather(NewGather().SetAction("/gather").Say(NewSay("Enter your pin")).Pause(1)).
+		Dial(NewDial().Conference(NewConference("room").DisableWaitURL())).
+		Say(NewSay("")).
+		Hangup()
+
+	want := header + `
+<Response>
+  <Gather action="/gather">
+    <Say>Enter your pin</Say>
+    <Pause length="1"/>
+  </Gather>
+  <Dial>
+    <Conference waitUrl="">room</Conference>
+  </Dial>
+  <Say/>
+  <Hangup/>
+</Response>`
+
+	got, err := r.RenderWith(ctx, WithSelfClosingEmpty())
+	if err != nil {
+		t.Fatalf("Response.RenderWith() error = %v", err)
+	}
+	if string(got) != want {
+		t.Errorf("Response.RenderWith() = %v, want %v", string(got), want)
+	}
+
+}
+
+func TestResponse_RenderTemplate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	header := xml.Header[:len(xml.Header)-1]
+
+	build := func() *Response {
+		return NewResponse().
+			Gather(NewGather().SetAction("{{base}}/gather?call={{call}}").Say(NewSay("Press {{digit}}"))).
+			Redirect(NewRedirect("{{base}}/start"))
+	}
+
+	tests := []struct {
+		name    string
+		data    map[string]string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Action and Redirect",
+			data: map[string]string{"base": "https://example.com", "call": "CA123"},
+			want: header + `
+<Response>
+  <Gather action="https://example.com/gather?call=CA123">
+    <Say>Press {{digit}}</Say>
+  </Gather>
+  <Redirect>https://example.com/start</Redirect>
+</Response>`,
+		},
+		{
+			name: "Values are not substituted again",
+			data: map[string]string{"base": "/{{call}}&", "call": "CA123"},
+			want: header + `
+<Response>
+  <Gather action="/{{call}}&amp;/gather?call=CA123">
+    <Say>Press {{digit}}</Say>
+  </Gather>
+  <Redirect>/{{call}}&amp;/start</Redirect>
+</Response>`,
+		},
+		{name: "Missing placeholder", data: map[string]string{"base": "https://example.com"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := build()
+			got, err := r.RenderTemplate(ctx, tt.data)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Response.RenderTemplate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Response.RenderTemplate() = %v, want %v", string(got), tt.want)
+			}
+			if d := r.Diff(build()); d != "" {
+				t.Errorf("Response.RenderTemplate() modified the Response: %s", d)
+			}
+		})
+	}
+}
+
+func TestResponse_RenderWith_Validation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	r := NewResponse().Gather(NewGather())
+	if _, err := r.RenderWith(ctx, WithValidation()); err == nil {
+		t.Errorf("Response.RenderWith() error = nil, want the error from Validate")
+	}
+	if _, err := r.RenderWith(ctx); err != nil {
+		t.Errorf("Response.RenderWith() error = %v, want nil without WithValidation", err)
+	}
+}
+
+func TestNewValidatingResponse(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		response *Response
+		wantErr  bool
+	}{
+		{name: "Valid", response: NewValidatingResponse().Say(NewSay("Hello"))},
+		{name: "Invalid", response: NewValidatingResponse().Gather(NewGather()), wantErr: true},
+		{name: "Invalid without validation", response: NewResponse().Gather(NewGather())},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if _, err := tt.response.Render(ctx); (err != nil) != tt.wantErr {
+				t.Errorf("Response.Render() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			var b strings.Builder
+			if err := tt.response.Stream(ctx, &b); (err != nil) != tt.wantErr {
+				t.Errorf("Response.Stream() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && b.Len() != 0 {
+				t.Errorf("Response.Stream() wrote %q before failing validation", b.String())
+			}
+			if _, err := tt.response.Clone().Render(ctx); (err != nil) != tt.wantErr {
+				t.Errorf("Response.Clone().Render() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestResponse_RenderWith_APIVersion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	r := NewResponse().Say(NewSay("Hello"))
+	want, err := r.Render(ctx)
+	if err != nil {
+		t.Fatalf("Response.Render() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		version string
+		wantErr bool
+	}{
+		{name: "Latest", version: APIVersion2010},
+		{name: "Legacy", version: "2008-08-01", wantErr: true},
+		{name: "Empty", version: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := r.RenderWith(ctx, WithAPIVersion(tt.version))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Response.RenderWith() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && string(got) != string(want) {
+				t.Errorf("Response.RenderWith() = %v, want %v", string(got), string(want))
+			}
+		})
+	}
+}
+
+// TestRender_AttributeOrder pins the order each verb renders its attributes in, which follows
+// the order of the struct fields. Reordering the fields changes the rendered TwiML, and breaks
+// snapshot tests downstream, so it must be a deliberate change to this table.
+func TestRender_AttributeOrder(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		verb Verb
+		want []string
+	}{
+		{verb: &Application{}, want: []string{"customerId"}},
+		{verb: &Client{}, want: nil},
+		{verb: &Conference{}, want: []string{
+			"muted", "beep", "startConferenceOnEnter", "endConferenceOnExit", "waitUrl", "waitMethod", "maxParticipants", "record", "region", "trim", "coach",
+			"statusCallbackEvent", "statusCallback", "statusCallbackMethod", "recordingStatusCallback", "recordingStatusCallbackMethod", "recordingStatusCallbackEvent", "eventCallbackUrl",
+		}},
+		{verb: &Connect{}, want: []string{"action", "method"}},
+		{verb: &ConversationRelay{}, want: []string{"url", "welcomeGreeting", "voice", "language", "ttsProvider", "transcriptionProvider"}},
+		{verb: &Dial{}, want: []string{"action", "method", "timeout", "answerOnBridge", "record", "recordingTrack", "recordingStatusCallback", "recordingStatusCallbackMethod"}},
+		{verb: &Enqueue{}, want: []string{"action", "method", "waitUrl", "waitUrlMethod", "workflowSid"}},
+		{verb: &Gather{}, want: []string{
+			"input", "action", "method", "timeout", "finishOnKey", "numDigits", "partialResultCallback", "partialResultCallbackMethod",
+			"language", "hints", "speechModel", "profanityFilter", "speechTimeout",
+		}},
+		{verb: &Hangup{}, want: nil},
+		{verb: &Identity{}, want: nil},
+		{verb: &Language{}, want: []string{"code", "ttsProvider", "voice", "transcriptionProvider", "speechModel"}},
+		{verb: &Number{}, want: []string{
+			"url", "method", "statusCallbackEvent", "statusCallback", "statusCallbackMethod", "machineDetection", "machineDetectionTimeout", "machineDetectionSpeechThreshold",
+			"machineDetectionSpeechEndThreshold", "machineDetectionSilenceTimeout", "amdStatusCallback", "amdStatusCallbackMethod",
+		}},
+		{verb: &Parameter{}, want: []string{"name", "value"}},
+		{verb: &Pause{}, want: []string{"length"}},
+		{verb: &Play{}, want: []string{"digits", "loop"}},
+		{verb: &Record{}, want: []string{
+			"action", "method", "timeout", "finishOnKey", "maxLength", "playBeep", "trim", "recordingStatusCallback", "recordingStatusCallbackMethod", "transcribe", "transcribeCallback",
+		}},
+		{verb: &Redirect{}, want: []string{"method"}},
+		{verb: &Refer{}, want: []string{"action", "method"}},
+		{verb: &Reject{}, want: []string{"reason"}},
+		{verb: &Say{}, want: []string{"voice", "loop"}},
+		{verb: &Sip{}, want: []string{"username", "password", "url", "method", "statusCallbackEvent", "statusCallback", "statusCallbackMethod"}},
+		{verb: &Start{}, want: nil},
+		{verb: &Stream{}, want: []string{"track", "name", "url", "statusCallback", "statusCallbackMethod"}},
+	}
+	for _, tt := range tests {
+		name := verbName(tt.verb)
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			fillAttrs(t, reflect.ValueOf(tt.verb).Elem())
+
+			b, err := xml.Marshal(tt.verb)
+			if err != nil {
+				t.Fatalf("xml.Marshal() error = %v", err)
+			}
+			tok, err := xml.NewDecoder(bytes.NewReader(b)).Token()
+			if err != nil {
+				t.Fatalf("xml.Decoder.Token() error = %v", err)
+			}
+			start, ok := tok.(xml.StartElement)
+			if !ok {
+				t.Fatalf("xml.Marshal() = %s, want an element", b)
+			}
+
+			var got []string
+			for _, attr := range start.Attr {
+				got = append(got, attr.Name.Local)
+			}
+			// Extra attributes are always rendered last
+			want := append(slices.Clip(tt.want), "extra")
+			if !slices.Equal(got, want) {
+				t.Errorf("%s attributes = %v, want %v", name, got, want)
+			}
+		})
+	}
+}
+
+// fillAttrs sets every attribute field of the verb v to a value which is rendered, and adds an
+// extra attribute
+func fillAttrs(t *testing.T, v reflect.Value) {
+	t.Helper()
+
+	for i := range v.NumField() {
+		field, tag := v.Field(i), v.Type().Field(i).Tag.Get("xml")
+		if tag == ",any,attr" {
+			field.Set(reflect.ValueOf([]xml.Attr{{Name: xml.Name{Local: "extra"}, Value: "1"}}))
+
+			continue
+		}
+		if !strings.Contains(tag, ",attr") {
+			continue
+		}
+		if field.Kind() == reflect.Ptr {
+			field.Set(reflect.New(field.Type().Elem()))
+			field = field.Elem()
+		}
+		switch field.Kind() {
+		case reflect.String:
+			field.SetString("1")
+		case reflect.Bool:
+			field.SetBool(true)
+		case reflect.Int:
+			field.SetInt(1)
+		case reflect.Uint:
+			field.SetUint(1)
+		default:
+			t.Fatalf("fillAttrs() unsupported attribute type %s", field.Type())
+		}
+	}
+}
+
+func TestRenderAll(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var responses []*Response
+	for i := range 50 {
+		responses = append(responses, NewResponse().Say(NewSay("Agent "+strconv.Itoa(i))))
+	}
+
+	got, err := RenderAll(ctx, responses)
+	if err != nil {
+		t.Fatalf("RenderAll() error = %v", err)
+	}
+	if len(got) != len(responses) {
+		t.Fatalf("RenderAll() returned %d results, want %d", len(got), len(responses))
+	}
+	for i, r := range responses {
+		want, err := r.Render(ctx)
+		if err != nil {
+			t.Fatalf("Response.Render() error = %v", err)
+		}
+		if string(got[i]) != string(want) {
+			t.Errorf("RenderAll()[%d] = %v, want %v", i, string(got[i]), string(want))
+		}
+	}
+
+	if got, err := RenderAll(ctx, nil); err != nil || len(got) != 0 {
+		t.Errorf("RenderAll(nil) = %v, %v, want no results", got, err)
+	}
+
+	invalid := append(slices.Clone(responses), NewValidatingResponse().Gather(NewGather()))
+	if got, err := RenderAll(ctx, invalid); err == nil || got != nil {
+		t.Errorf("RenderAll() = %v, %v, want the error from Render", got, err)
+	}
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := RenderAll(canceled, responses); err == nil {
+		t.Errorf("RenderAll() error = nil, want an error for a canceled context")
+	}
+}
+
+func BenchmarkRenderAll(b *testing.B) {
+	ctx := context.Background()
+
+	var responses []*Response
+	for i := range 100 {
+		responses = append(responses, NewResponse().
+			Say(NewSay("Joining the conference").SetVoice(AliceVoice)).
+			Dial(NewDial().Conference(NewConference("agent-"+strconv.Itoa(i)).SetStartConferenceOnEnter(true))))
+	}
+
+	b.Run("Sequential", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for _, r := range responses {
+				if _, err := r.Render(ctx); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+
+	b.Run("RenderAll", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := RenderAll(ctx, responses); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func TestResponse_RenderWith_XMLDeclaration(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	r := NewResponse().Say(NewSay("Hello"))
+	body := `
+<Response>
+  <Say>Hello</Say>
+</Response>`
+
+	tests := []struct {
+		name    string
+		decl    string
+		want    string
+		wantErr bool
+	}{
+		{name: "Default", want: xml.Header[:len(xml.Header)-1] + body},
+		{name: "Without encoding", decl: `<?xml version="1.0"?>`, want: `<?xml version="1.0"?>` + body},
+		{name: "Lowercase encoding", decl: `<?xml version='1.0' encoding='utf-8' standalone="yes" ?>`, want: `<?xml version='1.0' encoding='utf-8' standalone="yes" ?>` + body},
+		{name: "Other encoding", decl: `<?xml version="1.0" encoding="ISO-8859-1"?>`, wantErr: true},
+		{name: "No version", decl: `<?xml encoding="UTF-8"?>`, wantErr: true},
+		{name: "Not a declaration", decl: `<Response>`, wantErr: true},
+		{name: "Trailing content", decl: `<?xml version="1.0"?><Hangup/>`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var opts []RenderOption
+			if tt.decl != "" {
+				opts = append(opts, WithXMLDeclaration(tt.decl))
+			}
+			got, err := r.RenderWith(ctx, opts...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Response.RenderWith() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Response.RenderWith() = %v, want %v", string(got), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package twiml
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/go-playground/errors/v5"
+)
+
+// GatherAttemptParam is the query parameter which counts the attempts at a Gather, so that
+// the caller can be re-prompted differently when a Gather receives no input
+const GatherAttemptParam = "attempt"
+
+// AttemptURL returns rawURL with the GatherAttemptParam set to attempt. It is used as the
+// URL of the Redirect which follows a Gather, which Twilio only reaches when the Gather
+// receives no input, with the next attempt, which is read back with Request.GatherAttempt.
+func AttemptURL(rawURL string, attempt int) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", errors.Wrap(err, "url.Parse()")
+	}
+
+	q := u.Query()
+	q.Set(GatherAttemptParam, strconv.Itoa(attempt))
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
+
+// PromptForAttempt returns the prompt for the attempt at a Gather, where prompts are in
+// attempt order starting from 1, such as a full prompt followed by a shorter reminder. The
+// last prompt is repeated for attempts after it. It returns nil if there are no prompts.
+func PromptForAttempt(attempt int, prompts ...*Say) *Say {
+	if len(prompts) == 0 {
+		return nil
+	}
+
+	return prompts[max(min(attempt, len(prompts)), 1)-1]
+}
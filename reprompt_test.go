@@ -0,0 +1,112 @@
+package twiml
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func ExamplePromptForAttempt() {
+	ctx := context.Background()
+
+	menu := func(req *Request) (*Response, error) {
+		attempt := req.GatherAttempt()
+		if attempt > 3 {
+			return NewResponse().Say(NewSay("Goodbye")).Hangup(), nil
+		}
+
+		retry, err := AttemptURL("/menu", attempt+1)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewResponse().
+			Gather(NewGather().
+				SetAction("/choice").
+				SetNumDigits(1).
+				Say(PromptForAttempt(attempt,
+					NewSay("Thanks for calling. Press 1 for sales, or 2 for support."),
+					NewSay("Press 1 for sales, or 2 for support.")))).
+			Redirect(NewRedirect(retry)), nil
+	}
+
+	res, err := menu(NewRequest("https://example.com", httptest.NewRequest(http.MethodPost, "/menu?attempt=2", nil)))
+	if err != nil {
+		fmt.Println(err)
+
+		return
+	}
+	twiml, err := res.Render(ctx)
+	if err != nil {
+		fmt.Println(err)
+
+		return
+	}
+	fmt.Println(string(twiml))
+
+	// Output:
+	// <?xml version="1.0" encoding="UTF-8"?>
+	// <Response>
+	//   <Gather action="/choice" numDigits="1">
+	//     <Say>Press 1 for sales, or 2 for support.</Say>
+	//   </Gather>
+	//   <Redirect>/menu?attempt=3</Redirect>
+	// </Response>
+}
+
+func TestAttemptURL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		rawURL  string
+		attempt int
+		want    string
+		wantErr bool
+	}{
+		{name: "Path", rawURL: "/menu", attempt: 2, want: "/menu?attempt=2"},
+		{name: "Existing query", rawURL: "https://example.com/voice?step=menu&attempt=1", attempt: 2, want: "https://example.com/voice?attempt=2&step=menu"},
+		{name: "Malformed", rawURL: "/menu/%zz", attempt: 2, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := AttemptURL(tt.rawURL, tt.attempt)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("AttemptURL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("AttemptURL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPromptForAttempt(t *testing.T) {
+	t.Parallel()
+
+	first, second := NewSay("first"), NewSay("second")
+
+	tests := []struct {
+		name    string
+		attempt int
+		prompts []*Say
+		want    *Say
+	}{
+		{name: "First", attempt: 1, prompts: []*Say{first, second}, want: first},
+		{name: "Second", attempt: 2, prompts: []*Say{first, second}, want: second},
+		{name: "After the last", attempt: 5, prompts: []*Say{first, second}, want: second},
+		{name: "Zero", attempt: 0, prompts: []*Say{first, second}, want: first},
+		{name: "No prompts", attempt: 1, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := PromptForAttempt(tt.attempt, tt.prompts...); got != tt.want {
+				t.Errorf("PromptForAttempt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
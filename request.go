@@ -2,11 +2,17 @@
 package twiml
 
 import (
+	"bytes"
 	"context"
 	"crypto/hmac"
 	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
+	"io"
+	"mime"
 	"net/http"
 	"sort"
 	"strconv"
@@ -21,6 +27,161 @@ import (
 // RequestValues hold form values from a validated Request
 type RequestValues map[string]string
 
+// Get returns the value for key, or def if the key is missing or empty
+func (r RequestValues) Get(key, def string) string {
+	if v := r[key]; v != "" {
+		return v
+	}
+
+	return def
+}
+
+// Has reports whether key is present with a non-empty value
+func (r RequestValues) Has(key string) bool {
+	return r[key] != ""
+}
+
+// CallStatus is an enum for the status of a call
+type CallStatus string
+
+const (
+	// CallStatusQueued is a call waiting to be dialed
+	CallStatusQueued CallStatus = "queued"
+
+	// CallStatusRinging is a call which is ringing
+	CallStatusRinging CallStatus = "ringing"
+
+	// CallStatusInProgress is a call which was answered and is connected
+	CallStatusInProgress CallStatus = "in-progress"
+
+	// CallStatusCompleted is a call which was answered and has ended
+	CallStatusCompleted CallStatus = "completed"
+
+	// CallStatusBusy is a call which received a busy signal, or was rejected with Reject
+	CallStatusBusy CallStatus = "busy"
+
+	// CallStatusFailed is a call which could not be completed
+	CallStatusFailed CallStatus = "failed"
+
+	// CallStatusNoAnswer is a call which was not answered
+	CallStatusNoAnswer CallStatus = "no-answer"
+
+	// CallStatusCanceled is a call which was canceled while queued or ringing
+	CallStatusCanceled CallStatus = "canceled"
+)
+
+// CallStatus returns the CallStatus of the call
+func (r RequestValues) CallStatus() CallStatus {
+	return CallStatus(r["CallStatus"])
+}
+
+// Digits returns the digits pressed by the caller in response to a Gather
+func (r RequestValues) Digits() string {
+	return r["Digits"]
+}
+
+// GatherResult is the input from a Gather, as sent to its action
+type GatherResult struct {
+	Digits     string
+	Speech     string
+	Confidence float64
+	// Type is "dtmf" or "speech" for the input the caller gave, or "none" if they gave none
+	Type string
+}
+
+// GatherResult returns the input the caller gave to a Gather, from the Digits, SpeechResult
+// and Confidence values. Digits take precedence if both are somehow set. A Confidence which
+// is missing or can not be parsed is zero.
+func (r RequestValues) GatherResult() GatherResult {
+	result := GatherResult{Digits: r["Digits"], Speech: r["SpeechResult"], Type: "none"}
+	switch {
+	case result.Digits != "":
+		result.Type = string(DTMFInput)
+	case result.Speech != "":
+		result.Type = string(SpeechInput)
+		if confidence, err := strconv.ParseFloat(strings.TrimSpace(r["Confidence"]), 64); err == nil {
+			result.Confidence = confidence
+		}
+	}
+
+	return result
+}
+
+// UnstableSpeechResult returns the speech recognized so far, which may still change, from
+// the partialResultCallback of a Gather. Partial callbacks send UnstableSpeechResult,
+// StableSpeechResult and Stability, while only the final request to the action of the
+// Gather sends SpeechResult and Confidence (see GatherResult).
+func (r RequestValues) UnstableSpeechResult() string {
+	return r["UnstableSpeechResult"]
+}
+
+// SpeechConfidence parses the Confidence of the SpeechResult of a Gather, from 0 to 1. It is
+// zero, without an error, if there is no Confidence, such as when the caller pressed digits
+// or said nothing, so it is below any threshold.
+func (r RequestValues) SpeechConfidence() (float64, error) {
+	var confidence float64
+	if c := strings.TrimSpace(r["Confidence"]); c != "" {
+		f, err := strconv.ParseFloat(c, 64)
+		if err != nil {
+			return 0, errors.Wrap(err, "RequestValues.SpeechConfidence()")
+		}
+		confidence = f
+	}
+
+	return confidence, nil
+}
+
+// AboveConfidence reports whether the SpeechConfidence is at least threshold, so that speech
+// recognized with a lower confidence can be confirmed or prompted for again. A missing
+// Confidence is zero, as with SpeechConfidence.
+func (r RequestValues) AboveConfidence(threshold float64) (bool, error) {
+	confidence, err := r.SpeechConfidence()
+	if err != nil {
+		return false, errors.Wrap(err, "RequestValues.AboveConfidence()")
+	}
+
+	return confidence >= threshold, nil
+}
+
+// Stability parses how likely the UnstableSpeechResult of a partialResultCallback is to
+// change, from 0 to 1. It is zero if there is no Stability.
+func (r RequestValues) Stability() (float64, error) {
+	var stability float64
+	if r["Stability"] != "" {
+		s, err := strconv.ParseFloat(r["Stability"], 64)
+		if err != nil {
+			return 0, errors.Wrap(err, "RequestValues.Stability()")
+		}
+		stability = s
+	}
+
+	return stability, nil
+}
+
+// Parameters returns the custom parameters of the request, such as those passed with the
+// Parameter nouns of a Dial to a Client or Application, keyed by name. A value is custom if
+// its name is not one of the fields Twilio sends (see IsStandardField). Only names beginning
+// with prefix are returned, with the prefix removed, so an empty prefix returns every custom
+// parameter.
+func (r RequestValues) Parameters(prefix string) map[string]string {
+	params := make(map[string]string)
+	for k, v := range r {
+		if name, ok := strings.CutPrefix(k, prefix); ok && !IsStandardField(k) {
+			params[name] = v
+		}
+	}
+
+	return params
+}
+
+// IsStandardField reports whether name is one of the fields Twilio sends to webhooks, rather
+// than a custom parameter
+func IsStandardField(name string) bool {
+	_, ok := standardFields[name]
+
+	return ok
+}
+
 // CallDuration Parses the duration from the string value
 func (r RequestValues) CallDuration() (time.Duration, error) {
 	var duration int
@@ -35,6 +196,38 @@ func (r RequestValues) CallDuration() (time.Duration, error) {
 	return time.Second * time.Duration(duration), nil
 }
 
+// DialCallStatus returns the CallStatus of the call placed by a Dial, as sent to its action
+// when the Dial ends. A busy, failed or no-answer status is usually retried or sent elsewhere.
+func (r RequestValues) DialCallStatus() CallStatus {
+	return CallStatus(r["DialCallStatus"])
+}
+
+// DialCallSid returns the CallSid of the call placed by a Dial, as sent to its action
+func (r RequestValues) DialCallSid() string {
+	return r["DialCallSid"]
+}
+
+// DialCallDuration parses the duration of the call placed by a Dial, as sent to its action.
+// The duration is zero when the call was not answered.
+func (r RequestValues) DialCallDuration() (time.Duration, error) {
+	var duration int
+	if r["DialCallDuration"] != "" {
+		d, err := strconv.Atoi(r["DialCallDuration"])
+		if err != nil {
+			return 0, errors.Wrap(err, "RequestValues.DialCallDuration()")
+		}
+		duration = d
+	}
+
+	return time.Second * time.Duration(duration), nil
+}
+
+// DialBridged reports whether the caller was connected to the call placed by a Dial, as sent
+// to its action
+func (r RequestValues) DialBridged() bool {
+	return strings.EqualFold(r["DialBridged"], "true")
+}
+
 // SequenceNumber Parses the sequence number from the string value
 func (r RequestValues) SequenceNumber() (int, error) {
 	var seq int
@@ -49,6 +242,154 @@ func (r RequestValues) SequenceNumber() (int, error) {
 	return seq, nil
 }
 
+// QueuePosition parses the position of the caller in the queue, as sent to the waitUrl of
+// an Enqueue
+func (r RequestValues) QueuePosition() (int, error) {
+	var pos int
+	if r["QueuePosition"] != "" {
+		p, err := strconv.Atoi(r["QueuePosition"])
+		if err != nil {
+			return 0, errors.Wrap(err, "RequestValues.QueuePosition()")
+		}
+		pos = p
+	}
+
+	return pos, nil
+}
+
+// QueueTime parses how long the caller has been waiting in the queue, as sent to the
+// waitUrl of an Enqueue
+func (r RequestValues) QueueTime() (time.Duration, error) {
+	var seconds int
+	if r["QueueTime"] != "" {
+		s, err := strconv.Atoi(r["QueueTime"])
+		if err != nil {
+			return 0, errors.Wrap(err, "RequestValues.QueueTime()")
+		}
+		seconds = s
+	}
+
+	return time.Second * time.Duration(seconds), nil
+}
+
+// RecordingChannels parses the number of channels in a recording, as sent to the
+// recordingStatusCallback. A dual-channel recording has each leg of the call on its own channel.
+func (r RequestValues) RecordingChannels() (int, error) {
+	var channels int
+	if r["RecordingChannels"] != "" {
+		c, err := strconv.Atoi(r["RecordingChannels"])
+		if err != nil {
+			return 0, errors.Wrap(err, "RequestValues.RecordingChannels()")
+		}
+		channels = c
+	}
+
+	return channels, nil
+}
+
+// RecordingSource returns what started a recording, such as DialVerb or RecordVerb, as sent
+// to the recordingStatusCallback
+func (r RequestValues) RecordingSource() string {
+	return r["RecordingSource"]
+}
+
+// Common Twilio error codes, as sent to the fallback URL in ErrorCode
+const (
+	// ErrorCodeHTTPRetrievalFailure is a webhook which failed or returned an error status
+	ErrorCodeHTTPRetrievalFailure = 11200
+
+	// ErrorCodeHTTPConnectionFailure is a webhook which could not be connected to
+	ErrorCodeHTTPConnectionFailure = 11205
+
+	// ErrorCodeHTTPBadHostName is a webhook whose host name could not be resolved
+	ErrorCodeHTTPBadHostName = 11210
+
+	// ErrorCodeHTTPTooManyRedirects is a webhook which redirected too many times
+	ErrorCodeHTTPTooManyRedirects = 11215
+
+	// ErrorCodeDocumentParseFailure is a webhook which returned TwiML which could not be parsed
+	ErrorCodeDocumentParseFailure = 12100
+
+	// ErrorCodeSchemaValidationWarning is TwiML which does not match the TwiML schema
+	ErrorCodeSchemaValidationWarning = 12200
+
+	// ErrorCodeInvalidContentType is a webhook which returned an unsupported Content-Type
+	ErrorCodeInvalidContentType = 12300
+)
+
+// IsErrorCallback reports whether the request is Twilio reporting an error to the fallback URL
+func (r RequestValues) IsErrorCallback() bool {
+	return r.Has("ErrorCode")
+}
+
+// ErrorCode parses the Twilio error code sent to the fallback URL, which is zero if there is none
+func (r RequestValues) ErrorCode() (int, error) {
+	var code int
+	if r["ErrorCode"] != "" {
+		c, err := strconv.Atoi(r["ErrorCode"])
+		if err != nil {
+			return 0, errors.Wrap(err, "RequestValues.ErrorCode()")
+		}
+		code = c
+	}
+
+	return code, nil
+}
+
+// ErrorURL returns the URL of the webhook which caused the error sent to the fallback URL
+func (r RequestValues) ErrorURL() string {
+	return r["ErrorUrl"]
+}
+
+// PayResult is an enum for the Result of a Pay
+type PayResult string
+
+const (
+	// PayResultSuccess is a payment which was captured, or a card which was tokenized
+	PayResultSuccess PayResult = "success"
+
+	// PayResultTooManyFailedAttempts is a Pay which ended after the caller entered invalid
+	// payment information too many times
+	PayResultTooManyFailedAttempts PayResult = "too-many-failed-attempts"
+
+	// PayResultPaymentConnectorError is a Pay which failed in the payment connector or processor
+	PayResultPaymentConnectorError PayResult = "payment-connector-error"
+
+	// PayResultCallerInterruptedWithStar is a Pay which the caller left by pressing *
+	PayResultCallerInterruptedWithStar PayResult = "caller-interrupted-with-star"
+
+	// PayResultCallerHungUp is a Pay which ended when the caller hung up
+	PayResultCallerHungUp PayResult = "caller-hung-up"
+
+	// PayResultValidationError is a Pay which was configured with invalid attributes
+	PayResultValidationError PayResult = "validation-error"
+
+	// PayResultInternalError is a Pay which failed within Twilio
+	PayResultInternalError PayResult = "internal-error"
+)
+
+// PayResult returns the Result of a Pay, as sent to its action
+func (r RequestValues) PayResult() PayResult {
+	return PayResult(r["Result"])
+}
+
+// PaymentToken returns the token for the payment method, which can be charged later if the
+// Pay tokenized the card rather than charging it
+func (r RequestValues) PaymentToken() string {
+	return r["PaymentToken"]
+}
+
+// PaymentCardType returns the brand of card used with a Pay, such as visa or amex
+func (r RequestValues) PaymentCardType() string {
+	return r["PaymentCardType"]
+}
+
+// PayErrorType returns the ErrorType of a Pay which failed, such as a field the caller entered
+// invalid information for
+func (r RequestValues) PayErrorType() string {
+	return r["ErrorType"]
+}
+
 // TimestampOrNow parses the Timestamp from string. If Timestamp does not exist in the
 // current request, time.Now() is returned instead.
 func (r RequestValues) TimestampOrNow() time.Time {
@@ -60,6 +401,39 @@ func (r RequestValues) TimestampOrNow() time.Time {
 	return t
 }
 
+// ConferenceSid returns the ConferenceSid from a Conference status callback
+func (r RequestValues) ConferenceSid() string {
+	return r["ConferenceSid"]
+}
+
+// ConferenceEvent returns the StatusCallbackEvent from a Conference status callback
+func (r RequestValues) ConferenceEvent() string {
+	return r["StatusCallbackEvent"]
+}
+
+// ParticipantMuted reports whether the participant in a Conference status callback is muted.
+// Any value other than a case-insensitive "true" is treated as false.
+func (r RequestValues) ParticipantMuted() bool {
+	return strings.EqualFold(r["Muted"], "true")
+}
+
+// SipHeaderPrefix prefixes the name of each custom SIP header of an inbound SIP call, such as
+// X-Customer-Id, which Twilio sends as the field SipHeader_X-Customer-Id
+const SipHeaderPrefix = "SipHeader_"
+
+// SipHeaders returns the custom SIP headers of an inbound SIP call, keyed by header name with
+// SipHeaderPrefix removed. The map is empty for a call which is not over SIP.
+func (r RequestValues) SipHeaders() map[string]string {
+	headers := make(map[string]string)
+	for k, v := range r {
+		if name, ok := strings.CutPrefix(k, SipHeaderPrefix); ok && name != "" {
+			headers[name] = v
+		}
+	}
+
+	return headers
+}
+
 // From returns a Number parsed from the raw From value
 func (r RequestValues) From() *ParsedNumber {
 	return ParseNumber(r["From"])
@@ -133,9 +507,10 @@ func FormatNumber(number string) (string, error) {
 
 // Request is a twillio request expecting a TwiML response
 type Request struct {
-	host   string
-	r      *http.Request
-	Values RequestValues
+	host       string
+	r          *http.Request
+	validators map[string]valCfg
+	Values     RequestValues
 }
 
 // NewRequest returns Request
@@ -143,70 +518,229 @@ func NewRequest(host string, r *http.Request) *Request {
 	return &Request{host: host, r: r, Values: RequestValues{}}
 }
 
+// SetFieldCharset validates the form value of field against the allowed characters when the
+// request is validated, in place of any default validator for the field, such as the digits
+// and #* allowed for Digits. An empty value is still rejected.
+func (req *Request) SetFieldCharset(field, allowed string) *Request {
+	if req.validators == nil {
+		req.validators = make(map[string]valCfg)
+	}
+	req.validators[field] = valCfg{valFunc: validateCharset(allowed)}
+
+	return req
+}
+
+// Region returns the Twilio Region the request was sent from, such as us1 or ie1, as read
+// from the X-Home-Region header, or "" if it is not present. It is useful for logging and
+// routing in deployments which serve more than one Region. The header is not signed, so it
+// is only a hint, even for a request which was validated.
+func (req *Request) Region() string {
+	return req.r.Header.Get("X-Home-Region")
+}
+
+// Edge returns the Twilio Edge Location the request was sent through, such as ashburn or
+// dublin, as read from the X-Twilio-Edge header, or "" if it is not present. Like Region,
+// it is only a hint. For calls over SIP, the Region is also part of the SIP domain parsed by
+// ParseNumber.
+func (req *Request) Edge() string {
+	return req.r.Header.Get("X-Twilio-Edge")
+}
+
+// GatherAttempt returns the attempt at a Gather which the request is for, from the
+// GatherAttemptParam parameter of the URL (see AttemptURL), or of the form values as with the
+// step of a Flow. It is 1 if the parameter is missing or invalid.
+func (req *Request) GatherAttempt() int {
+	value := req.r.URL.Query().Get(GatherAttemptParam)
+	if value == "" {
+		value = req.Values[GatherAttemptParam]
+	}
+
+	attempt, err := strconv.Atoi(value)
+	if err != nil || attempt < 1 {
+		return 1
+	}
+
+	return attempt
+}
+
 // ValidatePost validates the Twilio Signature, requiring that the request is a POST
 func (req *Request) ValidatePost(ctx context.Context, authToken string) error {
 	_, span := trace.StartSpan(ctx, "twiml.Request.ValidatePost()")
 	defer span.End()
 
+	return req.validatePost(span, "twiml.Request.ValidatePost()", func(string) (string, error) { return authToken, nil })
+}
+
+// ValidatePostFunc validates the Twilio Signature like ValidatePost, for deployments with
+// several Twilio accounts. The auth token is looked up by the AccountSid of the request,
+// from the form values, or the query string for a JSON body.
+//
+// The signature is calculated and compared even when the lookup fails, and the comparison
+// is constant time, so the time taken does not reveal whether an AccountSid is known.
+func (req *Request) ValidatePostFunc(ctx context.Context, authToken func(accountSid string) (string, error)) error {
+	_, span := trace.StartSpan(ctx, "twiml.Request.ValidatePostFunc()")
+	defer span.End()
+
+	return req.validatePost(span, "twiml.Request.ValidatePostFunc()", authToken)
+}
+
+func (req *Request) validatePost(span *trace.Span, op string, authToken func(accountSid string) (string, error)) error {
 	url := req.host + req.r.URL.String()
 	span.AddAttributes(trace.StringAttribute("url", url))
 
 	if req.r.Method != "POST" {
-		return errors.Wrap(fmt.Errorf("expected a POST request, received %s", req.r.Method), "twiml.Request.ValidatePost()")
+		return errors.Wrap(fmt.Errorf("expected a POST request, received %s", req.r.Method), op)
+	}
+
+	// Twilio signs a JSON body by adding its hash to the URL as the bodySHA256 query
+	// parameter, in place of the form values it signs for a form body
+	var form map[string][]string
+	accountSid := req.r.URL.Query().Get("AccountSid")
+	if mediaType, _, _ := mime.ParseMediaType(req.r.Header.Get("Content-Type")); mediaType == "application/json" {
+		if err := req.validateBodySHA256(); err != nil {
+			return errors.Wrap(err, op)
+		}
+	} else {
+		if err := req.r.ParseForm(); err != nil {
+			return errors.Wrap(err, "http.Request.ParseForm()")
+		}
+		form = req.r.PostForm
+		accountSid = req.r.PostForm.Get("AccountSid")
+	}
+
+	token, lookupErr := authToken(accountSid)
+	sig, err := signature(url, form, token)
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+
+	var xTwilioSig string
+	xTwilioSigHdr := req.r.Header[http.CanonicalHeaderKey("X-Twilio-Signature")]
+	if len(xTwilioSigHdr) == 1 {
+		xTwilioSig = xTwilioSigHdr[0]
+	}
+	match := subtle.ConstantTimeCompare([]byte(sig), []byte(xTwilioSig)) == 1
+
+	if lookupErr != nil {
+		return errors.Wrapf(lookupErr, "%s: auth token for AccountSid %q", op, accountSid)
+	}
+	if len(xTwilioSigHdr) != 1 || !match {
+		return errors.Wrap(fmt.Errorf("calculated Signature: %s, failed to match X-Twilio-Signature: %s", sig, xTwilioSig), op)
+	}
+
+	// Validate data
+	for _, p := range sortedKeys(form) {
+		var val string
+		if len(form[p]) > 0 {
+			val = form[p][0]
+		}
+		valParam, ok := req.validators[p]
+		if !ok {
+			valParam, ok = fieldValidators[p]
+		}
+		if ok {
+			if err := valParam.valFunc(val, valParam.valParam); err != nil {
+				return errors.Wrapf(err, "Invalid form value: %s=%s", p, val)
+			}
+		}
+		req.Values[p] = val
+	}
+
+	return nil
+}
+
+// validateBodySHA256 checks the hash of the request body against the bodySHA256 query
+// parameter. The body is read and then replaced, so it can still be read by the handler.
+func (req *Request) validateBodySHA256() error {
+	want := req.r.URL.Query().Get("bodySHA256")
+	if want == "" {
+		return errors.New("JSON body without a bodySHA256 query parameter")
 	}
 
-	if err := req.r.ParseForm(); err != nil {
+	body, err := io.ReadAll(req.r.Body)
+	if err != nil {
+		return errors.Wrap(err, "io.ReadAll()")
+	}
+	if err := req.r.Body.Close(); err != nil {
+		return errors.Wrap(err, "io.ReadCloser.Close()")
+	}
+	req.r.Body = io.NopCloser(bytes.NewReader(body))
+
+	sum := sha256.Sum256(body)
+	if got := hex.EncodeToString(sum[:]); subtle.ConstantTimeCompare([]byte(got), []byte(strings.ToLower(want))) != 1 {
+		return fmt.Errorf("calculated bodySHA256: %s, failed to match bodySHA256 query parameter: %s", got, want)
+	}
+
+	return nil
+}
+
+// SignRequest sets the X-Twilio-Signature header on r as Twilio would when sending r to url.
+// It is intended for tests which exercise ValidatePost through a real request. For a JSON
+// body, url must already include the bodySHA256 query parameter.
+func SignRequest(r *http.Request, url, authToken string) error {
+	if err := r.ParseForm(); err != nil {
 		return errors.Wrap(err, "http.Request.ParseForm()")
 	}
 
-	params := make([]string, 0, len(req.r.PostForm))
-	for p := range req.r.PostForm {
-		params = append(params, p)
+	sig, err := signature(url, r.PostForm, authToken)
+	if err != nil {
+		return errors.Wrap(err, "twiml.SignRequest()")
 	}
-	sort.Strings(params)
+	r.Header.Set("X-Twilio-Signature", sig)
+
+	return nil
+}
 
+// signature calculates the Twilio Signature for a request to url with the given form values
+func signature(url string, form map[string][]string, authToken string) (string, error) {
 	message := url
-	for _, p := range params {
+	for _, p := range sortedKeys(form) {
 		message += p
-		if len(req.r.PostForm[p]) > 0 {
-			message += req.r.PostForm[p][0]
+		if len(form[p]) > 0 {
+			message += form[p][0]
 		}
 	}
 
 	hash := hmac.New(sha1.New, []byte(authToken))
 	if n, err := hash.Write([]byte(message)); err != nil {
-		return errors.Wrap(err, "twiml.Request.ValidatePost(): hash.Write()")
+		return "", errors.Wrap(err, "hash.Write()")
 	} else if n != len(message) {
 		err := fmt.Errorf("expected %d bytes, got %d bytes", len(message), n)
 
-		return errors.Wrap(err, "twiml.Request.ValidatePost(): hash.Write()")
+		return "", errors.Wrap(err, "hash.Write()")
 	}
-	sig := base64.StdEncoding.EncodeToString(hash.Sum(nil))
 
-	if xTwilioSigHdr := req.r.Header[http.CanonicalHeaderKey("X-Twilio-Signature")]; len(xTwilioSigHdr) != 1 || sig != xTwilioSigHdr[0] {
-		var xTwilioSig string
-		if len(xTwilioSigHdr) == 1 {
-			xTwilioSig = xTwilioSigHdr[0]
-		}
+	return base64.StdEncoding.EncodeToString(hash.Sum(nil)), nil
+}
 
-		return errors.Wrap(fmt.Errorf("calculated Signature: %s, failed to match X-Twilio-Signature: %s", sig, xTwilioSig), "twiml.Request.ValidatePost()")
+func sortedKeys(form map[string][]string) []string {
+	keys := make([]string, 0, len(form))
+	for k := range form {
+		keys = append(keys, k)
 	}
+	sort.Strings(keys)
 
-	// Validate data
-	for _, p := range params {
-		var val string
-		if len(req.r.PostForm[p]) > 0 {
-			val = req.r.PostForm[p][0]
-		}
-		if valParam, ok := fieldValidators[p]; ok {
-			if err := valParam.valFunc(val, valParam.valParam); err != nil {
-				return errors.Wrapf(err, "Invalid form value: %s=%s", p, val)
-			}
-		}
-		req.Values[p] = val
-	}
+	return keys
+}
 
-	return nil
+// standardFields are the fields Twilio sends to voice webhooks and callbacks
+var standardFields = map[string]struct{}{
+	"AccountSid": {}, "ApiVersion": {}, "ApplicationSid": {}, "CallSid": {}, "CallStatus": {}, "CallToken": {},
+	"Called": {}, "CalledCity": {}, "CalledCountry": {}, "CalledState": {}, "CalledZip": {},
+	"Caller": {}, "CallerCity": {}, "CallerCountry": {}, "CallerName": {}, "CallerState": {}, "CallerZip": {},
+	"Direction": {}, "ForwardedFrom": {}, "ParentCallSid": {}, "StirVerstat": {},
+	"From": {}, "FromCity": {}, "FromCountry": {}, "FromState": {}, "FromZip": {},
+	"To": {}, "ToCity": {}, "ToCountry": {}, "ToState": {}, "ToZip": {},
+	"SipDomain": {}, "SipUsername": {}, "SipCallId": {}, "SipSourceIp": {},
+	"CallDuration": {}, "Duration": {}, "Timestamp": {}, "SequenceNumber": {}, "CallbackSource": {},
+	"Digits": {}, "FinishedOnKey": {}, "SpeechResult": {}, "Confidence": {}, "msg": {},
+	"UnstableSpeechResult": {}, "StableSpeechResult": {}, "Stability": {},
+	"DialCallSid": {}, "DialCallStatus": {}, "DialCallDuration": {}, "DialBridged": {},
+	"RecordingUrl": {}, "RecordingSid": {}, "RecordingDuration": {}, "RecordingStatus": {}, "RecordingChannels": {}, "RecordingSource": {},
+	"QueueSid": {}, "QueuePosition": {}, "QueueTime": {}, "QueueResult": {}, "DequeuingCallSid": {},
+	"ConferenceSid": {}, "FriendlyName": {}, "StatusCallbackEvent": {}, "Muted": {}, "Hold": {}, "Coaching": {},
+	"ErrorCode": {}, "ErrorUrl": {}, "AddOns": {},
+	"Result": {}, "PaymentToken": {}, "PaymentMethod": {}, "PaymentCardType": {}, "PaymentCardNumber": {}, "ProfileId": {}, "ErrorType": {},
 }
 
 type valCfg struct {
@@ -1,7 +1,16 @@
 package twiml
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 )
@@ -33,6 +42,426 @@ func TestRequestValues_Duration(t *testing.T) {
 	}
 }
 
+func TestRequestValues_Dial(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		r            RequestValues
+		wantStatus   CallStatus
+		wantSid      string
+		wantDuration time.Duration
+		wantBridged  bool
+		wantErr      bool
+	}{
+		{
+			name:         "Completed",
+			r:            RequestValues{"DialCallStatus": "completed", "DialCallSid": "CA123", "DialCallDuration": "42", "DialBridged": "true"},
+			wantStatus:   CallStatusCompleted,
+			wantSid:      "CA123",
+			wantDuration: 42 * time.Second,
+			wantBridged:  true,
+		},
+		{
+			name:       "No answer",
+			r:          RequestValues{"DialCallStatus": "no-answer", "DialCallSid": "CA456", "DialBridged": "false"},
+			wantStatus: CallStatusNoAnswer,
+			wantSid:    "CA456",
+		},
+		{name: "Missing", r: RequestValues{}},
+		{name: "Invalid duration", r: RequestValues{"DialCallDuration": "42s"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tt.r.DialCallStatus(); got != tt.wantStatus {
+				t.Errorf("RequestValues.DialCallStatus() = %v, want %v", got, tt.wantStatus)
+			}
+			if got := tt.r.DialCallSid(); got != tt.wantSid {
+				t.Errorf("RequestValues.DialCallSid() = %v, want %v", got, tt.wantSid)
+			}
+			if got := tt.r.DialBridged(); got != tt.wantBridged {
+				t.Errorf("RequestValues.DialBridged() = %v, want %v", got, tt.wantBridged)
+			}
+			got, err := tt.r.DialCallDuration()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RequestValues.DialCallDuration() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.wantDuration {
+				t.Errorf("RequestValues.DialCallDuration() = %v, want %v", got, tt.wantDuration)
+			}
+		})
+	}
+}
+
+func TestRequestValues_Get(t *testing.T) {
+	t.Parallel()
+
+	r := RequestValues{"Digits": "1234", "SpeechResult": ""}
+
+	tests := []struct {
+		name    string
+		key     string
+		def     string
+		want    string
+		wantHas bool
+	}{
+		{name: "Present", key: "Digits", def: "0", want: "1234", wantHas: true},
+		{name: "Empty", key: "SpeechResult", def: "none", want: "none", wantHas: false},
+		{name: "Missing", key: "CallStatus", def: "unknown", want: "unknown", wantHas: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := r.Get(tt.key, tt.def); got != tt.want {
+				t.Errorf("RequestValues.Get() = %v, want %v", got, tt.want)
+			}
+			if got := r.Has(tt.key); got != tt.wantHas {
+				t.Errorf("RequestValues.Has() = %v, want %v", got, tt.wantHas)
+			}
+		})
+	}
+}
+
+func TestRequestValues_Conference(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		r         RequestValues
+		wantSid   string
+		wantEvent string
+		wantMuted bool
+	}{
+		{name: "Muted", r: RequestValues{"ConferenceSid": "CF123", "StatusCallbackEvent": "participant-mute", "Muted": "true"}, wantSid: "CF123", wantEvent: "participant-mute", wantMuted: true},
+		{name: "Muted mixed case", r: RequestValues{"Muted": "True"}, wantMuted: true},
+		{name: "Unmuted", r: RequestValues{"ConferenceSid": "CF123", "StatusCallbackEvent": "participant-unmute", "Muted": "false"}, wantSid: "CF123", wantEvent: "participant-unmute", wantMuted: false},
+		{name: "Missing", r: RequestValues{}, wantMuted: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tt.r.ConferenceSid(); got != tt.wantSid {
+				t.Errorf("RequestValues.ConferenceSid() = %v, want %v", got, tt.wantSid)
+			}
+			if got := tt.r.ConferenceEvent(); got != tt.wantEvent {
+				t.Errorf("RequestValues.ConferenceEvent() = %v, want %v", got, tt.wantEvent)
+			}
+			if got := tt.r.ParticipantMuted(); got != tt.wantMuted {
+				t.Errorf("RequestValues.ParticipantMuted() = %v, want %v", got, tt.wantMuted)
+			}
+		})
+	}
+}
+
+func TestRequestValues_GatherResult(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		values RequestValues
+		want   GatherResult
+	}{
+		{name: "None", values: RequestValues{"Digits": "", "SpeechResult": ""}, want: GatherResult{Type: "none"}},
+		{name: "DTMF", values: RequestValues{"Digits": "1234#"}, want: GatherResult{Digits: "1234#", Type: "dtmf"}},
+		{name: "Speech", values: RequestValues{"SpeechResult": "sales", "Confidence": "0.9342"}, want: GatherResult{Speech: "sales", Confidence: 0.9342, Type: "speech"}},
+		{name: "Speech without confidence", values: RequestValues{"SpeechResult": "sales"}, want: GatherResult{Speech: "sales", Type: "speech"}},
+		{name: "Speech with invalid confidence", values: RequestValues{"SpeechResult": "sales", "Confidence": "high"}, want: GatherResult{Speech: "sales", Type: "speech"}},
+		{name: "Both", values: RequestValues{"Digits": "1", "SpeechResult": "sales"}, want: GatherResult{Digits: "1", Speech: "sales", Type: "dtmf"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tt.values.GatherResult(); got != tt.want {
+				t.Errorf("RequestValues.GatherResult() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRequestValues_Queue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		values       RequestValues
+		wantPosition int
+		wantTime     time.Duration
+		wantErr      bool
+	}{
+		{name: "Set", values: RequestValues{"QueuePosition": "3", "QueueTime": "45"}, wantPosition: 3, wantTime: 45 * time.Second},
+		{name: "Unset", values: RequestValues{}},
+		{name: "Invalid", values: RequestValues{"QueuePosition": "third", "QueueTime": "long"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			pos, err := tt.values.QueuePosition()
+			if (err != nil) != tt.wantErr || pos != tt.wantPosition {
+				t.Errorf("RequestValues.QueuePosition() = %v, %v, want %v, wantErr %v", pos, err, tt.wantPosition, tt.wantErr)
+			}
+			d, err := tt.values.QueueTime()
+			if (err != nil) != tt.wantErr || d != tt.wantTime {
+				t.Errorf("RequestValues.QueueTime() = %v, %v, want %v, wantErr %v", d, err, tt.wantTime, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRequestValues_Recording(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		values       RequestValues
+		wantChannels int
+		wantSource   string
+		wantErr      bool
+	}{
+		{name: "Dual channel", values: RequestValues{"RecordingChannels": "2", "RecordingSource": "DialVerb"}, wantChannels: 2, wantSource: "DialVerb"},
+		{name: "Unset", values: RequestValues{}},
+		{name: "Invalid", values: RequestValues{"RecordingChannels": "two", "RecordingSource": "RecordVerb"}, wantSource: "RecordVerb", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			channels, err := tt.values.RecordingChannels()
+			if (err != nil) != tt.wantErr || channels != tt.wantChannels {
+				t.Errorf("RequestValues.RecordingChannels() = %v, %v, want %v, wantErr %v", channels, err, tt.wantChannels, tt.wantErr)
+			}
+			if got := tt.values.RecordingSource(); got != tt.wantSource {
+				t.Errorf("RequestValues.RecordingSource() = %v, want %v", got, tt.wantSource)
+			}
+		})
+	}
+}
+
+func TestRequestValues_ErrorCode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		values  RequestValues
+		wantIs  bool
+		want    int
+		wantURL string
+		wantErr bool
+	}{
+		{name: "Error", values: RequestValues{"ErrorCode": "11200", "ErrorUrl": "https://example.com/voice"}, wantIs: true, want: ErrorCodeHTTPRetrievalFailure, wantURL: "https://example.com/voice"},
+		{name: "No error", values: RequestValues{"CallStatus": "in-progress"}},
+		{name: "Invalid", values: RequestValues{"ErrorCode": "eleven"}, wantIs: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tt.values.IsErrorCallback(); got != tt.wantIs {
+				t.Errorf("RequestValues.IsErrorCallback() = %v, want %v", got, tt.wantIs)
+			}
+			got, err := tt.values.ErrorCode()
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("RequestValues.ErrorCode() = %v, %v, want %v, wantErr %v", got, err, tt.want, tt.wantErr)
+			}
+			if got := tt.values.ErrorURL(); got != tt.wantURL {
+				t.Errorf("RequestValues.ErrorURL() = %v, want %v", got, tt.wantURL)
+			}
+		})
+	}
+}
+
+func TestRequestValues_Pay(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		values        RequestValues
+		wantResult    PayResult
+		wantToken     string
+		wantCardType  string
+		wantErrorType string
+	}{
+		{
+			name:         "Success",
+			values:       RequestValues{"Result": "success", "PaymentToken": "tok_123", "PaymentCardType": "visa", "PaymentCardNumber": "xxxx-xxxx-xxxx-1111"},
+			wantResult:   PayResultSuccess,
+			wantToken:    "tok_123",
+			wantCardType: "visa",
+		},
+		{
+			name:          "Failed attempts",
+			values:        RequestValues{"Result": "too-many-failed-attempts", "ErrorType": "invalid-card-number"},
+			wantResult:    PayResultTooManyFailedAttempts,
+			wantErrorType: "invalid-card-number",
+		},
+		{name: "Not a Pay", values: RequestValues{"CallStatus": "in-progress"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tt.values.PayResult(); got != tt.wantResult {
+				t.Errorf("RequestValues.PayResult() = %v, want %v", got, tt.wantResult)
+			}
+			if got := tt.values.PaymentToken(); got != tt.wantToken {
+				t.Errorf("RequestValues.PaymentToken() = %v, want %v", got, tt.wantToken)
+			}
+			if got := tt.values.PaymentCardType(); got != tt.wantCardType {
+				t.Errorf("RequestValues.PaymentCardType() = %v, want %v", got, tt.wantCardType)
+			}
+			if got := tt.values.PayErrorType(); got != tt.wantErrorType {
+				t.Errorf("RequestValues.PayErrorType() = %v, want %v", got, tt.wantErrorType)
+			}
+			if got := tt.values.Parameters(""); len(got) != 0 {
+				t.Errorf("RequestValues.Parameters() = %v, want none", got)
+			}
+		})
+	}
+}
+
+func TestRequestValues_SpeechConfidence(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		values    RequestValues
+		want      float64
+		wantAbove bool
+		wantErr   bool
+	}{
+		{name: "Above", values: RequestValues{"SpeechResult": "sales", "Confidence": "0.92"}, want: 0.92, wantAbove: true},
+		{name: "At threshold", values: RequestValues{"SpeechResult": "sales", "Confidence": "0.6"}, want: 0.6, wantAbove: true},
+		{name: "Below", values: RequestValues{"SpeechResult": "sails", "Confidence": "0.31"}, want: 0.31},
+		{name: "Absent", values: RequestValues{"Digits": "1"}},
+		{name: "Empty", values: RequestValues{"SpeechResult": "", "Confidence": ""}},
+		{name: "Invalid", values: RequestValues{"Confidence": "high"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := tt.values.SpeechConfidence()
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("RequestValues.SpeechConfidence() = %v, %v, want %v, wantErr %v", got, err, tt.want, tt.wantErr)
+			}
+			above, err := tt.values.AboveConfidence(0.6)
+			if (err != nil) != tt.wantErr || above != tt.wantAbove {
+				t.Errorf("RequestValues.AboveConfidence() = %v, %v, want %v, wantErr %v", above, err, tt.wantAbove, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRequestValues_UnstableSpeechResult(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		values        RequestValues
+		wantSpeech    string
+		wantStability float64
+		wantErr       bool
+	}{
+		{name: "Partial", values: RequestValues{"UnstableSpeechResult": "sales and", "Stability": "0.8"}, wantSpeech: "sales and", wantStability: 0.8},
+		{name: "Final", values: RequestValues{"SpeechResult": "sales"}},
+		{name: "Invalid stability", values: RequestValues{"UnstableSpeechResult": "sales", "Stability": "stable"}, wantSpeech: "sales", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tt.values.UnstableSpeechResult(); got != tt.wantSpeech {
+				t.Errorf("RequestValues.UnstableSpeechResult() = %v, want %v", got, tt.wantSpeech)
+			}
+			got, err := tt.values.Stability()
+			if (err != nil) != tt.wantErr || got != tt.wantStability {
+				t.Errorf("RequestValues.Stability() = %v, %v, want %v, wantErr %v", got, err, tt.wantStability, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRequestValues_Parameters(t *testing.T) {
+	t.Parallel()
+
+	values := RequestValues{
+		"CallSid":     "CA123",
+		"From":        "client:alice",
+		"To":          "+18005642365",
+		"customer_id": "42",
+		"x_ticket":    "T-1",
+		"x_priority":  "high",
+	}
+
+	tests := []struct {
+		name   string
+		prefix string
+		want   map[string]string
+	}{
+		{name: "All", prefix: "", want: map[string]string{"customer_id": "42", "x_ticket": "T-1", "x_priority": "high"}},
+		{name: "Prefix", prefix: "x_", want: map[string]string{"ticket": "T-1", "priority": "high"}},
+		{name: "No match", prefix: "y_", want: map[string]string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := values.Parameters(tt.prefix); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RequestValues.Parameters() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRequestValues_SipHeaders(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		r    RequestValues
+		want map[string]string
+	}{
+		{
+			name: "SIP call",
+			r:    RequestValues{"CallSid": "CA123", "SipHeader_X-Customer-Id": "42", "SipHeader_User-to-User": "56a390f3d2b7310023a2;encoding=hex", "SipHeader_": "ignored"},
+			want: map[string]string{"X-Customer-Id": "42", "User-to-User": "56a390f3d2b7310023a2;encoding=hex"},
+		},
+		{name: "Not SIP", r: RequestValues{"CallSid": "CA123"}, want: map[string]string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tt.r.SipHeaders(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RequestValues.SipHeaders() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRequest_GatherAttempt(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		target string
+		values RequestValues
+		want   int
+	}{
+		{name: "Missing", target: "/menu", want: 1},
+		{name: "Query", target: "/menu?attempt=2", want: 2},
+		{name: "Form", target: "/menu", values: RequestValues{"attempt": "3"}, want: 3},
+		{name: "Query before form", target: "/menu?attempt=2", values: RequestValues{"attempt": "3"}, want: 2},
+		{name: "Invalid", target: "/menu?attempt=two", want: 1},
+		{name: "Zero", target: "/menu?attempt=0", want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			req := NewRequest("https://example.com", httptest.NewRequest(http.MethodPost, tt.target, nil))
+			for k, v := range tt.values {
+				req.Values[k] = v
+			}
+			if got := req.GatherAttempt(); got != tt.want {
+				t.Errorf("Request.GatherAttempt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestParseNumber(t *testing.T) {
 	t.Parallel()
 
@@ -60,3 +489,218 @@ func TestParseNumber(t *testing.T) {
 		})
 	}
 }
+
+func TestSignRequest(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	newRequest := func() *http.Request {
+		form := url.Values{"From": {"+18005642365"}, "To": {"+18005642366"}, "Digits": {"1234#"}}
+		r := httptest.NewRequest(http.MethodPost, "/voice?step=menu", strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		return r
+	}
+
+	tests := []struct {
+		name      string
+		signURL   string
+		signToken string
+		wantErr   bool
+	}{
+		{name: "Valid", signURL: "https://example.com/voice?step=menu", signToken: "token", wantErr: false},
+		{name: "Wrong token", signURL: "https://example.com/voice?step=menu", signToken: "other", wantErr: true},
+		{name: "Wrong URL", signURL: "https://example.com/voice", signToken: "token", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := newRequest()
+			if err := SignRequest(r, tt.signURL, tt.signToken); err != nil {
+				t.Fatalf("SignRequest() error = %v", err)
+			}
+			req := NewRequest("https://example.com", r)
+			if err := req.ValidatePost(ctx, "token"); (err != nil) != tt.wantErr {
+				t.Errorf("Request.ValidatePost() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && req.Values["Digits"] != "1234#" {
+				t.Errorf("Request.Values[Digits] = %v, want %v", req.Values["Digits"], "1234#")
+			}
+		})
+	}
+}
+
+func TestRequest_ValidatePost_JSON(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	body := `{"StreamSid":"MZ123","Status":"completed"}`
+	sum := sha256.Sum256([]byte(body))
+	bodySHA256 := hex.EncodeToString(sum[:])
+
+	tests := []struct {
+		name    string
+		target  string
+		body    string
+		wantErr bool
+	}{
+		{name: "Valid", target: "/events?bodySHA256=" + bodySHA256, body: body, wantErr: false},
+		{name: "Modified body", target: "/events?bodySHA256=" + bodySHA256, body: strings.Replace(body, "completed", "failed", 1), wantErr: true},
+		{name: "Missing bodySHA256", target: "/events", body: body, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := httptest.NewRequest(http.MethodPost, tt.target, strings.NewReader(tt.body))
+			r.Header.Set("Content-Type", "application/json; charset=utf-8")
+			if err := SignRequest(r, "https://example.com"+tt.target, "token"); err != nil {
+				t.Fatalf("SignRequest() error = %v", err)
+			}
+
+			if err := NewRequest("https://example.com", r).ValidatePost(ctx, "token"); (err != nil) != tt.wantErr {
+				t.Errorf("Request.ValidatePost() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got, err := io.ReadAll(r.Body); err != nil || string(got) != tt.body {
+				t.Errorf("http.Request.Body = %s, %v, want %s", got, err, tt.body)
+			}
+		})
+	}
+}
+
+func TestRequest_ValidatePost_Query(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	target := "/voice?attempt=2&CallSid=CA999"
+	r := httptest.NewRequest(http.MethodPost, target, strings.NewReader(url.Values{"CallSid": {"CA123"}}.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if err := SignRequest(r, "https://example.com"+target, "token"); err != nil {
+		t.Fatalf("SignRequest() error = %v", err)
+	}
+
+	req := NewRequest("https://example.com", r)
+	if err := req.ValidatePost(ctx, "token"); err != nil {
+		t.Fatalf("Request.ValidatePost() error = %v", err)
+	}
+	if want := (RequestValues{"CallSid": "CA123"}); !reflect.DeepEqual(req.Values, want) {
+		t.Errorf("Request.Values = %v, want only the form values %v", req.Values, want)
+	}
+	if got := req.GatherAttempt(); got != 2 {
+		t.Errorf("Request.GatherAttempt() = %v, want 2", got)
+	}
+}
+
+func TestRequest_SetFieldCharset(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		digits  string
+		charset string
+		wantErr bool
+	}{
+		{name: "Default allows pound", digits: "1234#"},
+		{name: "Default rejects letters", digits: "12A", wantErr: true},
+		{name: "Digits only allows digits", digits: "1234", charset: "0123456789"},
+		{name: "Digits only rejects pound", digits: "1234#", charset: "0123456789", wantErr: true},
+		{name: "Extended allows letters", digits: "12A", charset: "0123456789#*ABCD"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := httptest.NewRequest(http.MethodPost, "/gather", strings.NewReader(url.Values{"Digits": {tt.digits}}.Encode()))
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			if err := SignRequest(r, "https://example.com/gather", "token"); err != nil {
+				t.Fatalf("SignRequest() error = %v", err)
+			}
+
+			req := NewRequest("https://example.com", r)
+			if tt.charset != "" {
+				req.SetFieldCharset("Digits", tt.charset)
+			}
+			if err := req.ValidatePost(ctx, "token"); (err != nil) != tt.wantErr {
+				t.Errorf("Request.ValidatePost() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRequest_RegionEdge(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		header     http.Header
+		wantRegion string
+		wantEdge   string
+	}{
+		{name: "Present", header: http.Header{"X-Home-Region": {"ie1"}, "X-Twilio-Edge": {"dublin"}}, wantRegion: "ie1", wantEdge: "dublin"},
+		{name: "Missing", header: http.Header{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := httptest.NewRequest(http.MethodPost, "/voice", nil)
+			r.Header = tt.header
+			req := NewRequest("example.com", r)
+			if got := req.Region(); got != tt.wantRegion {
+				t.Errorf("Request.Region() = %v, want %v", got, tt.wantRegion)
+			}
+			if got := req.Edge(); got != tt.wantEdge {
+				t.Errorf("Request.Edge() = %v, want %v", got, tt.wantEdge)
+			}
+		})
+	}
+}
+
+func TestRequest_ValidatePostFunc(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tokens := map[string]string{"AC1": "token1", "AC2": "token2"}
+	lookup := func(accountSid string) (string, error) {
+		token, ok := tokens[accountSid]
+		if !ok {
+			return "", fmt.Errorf("unknown account %q", accountSid)
+		}
+
+		return token, nil
+	}
+
+	tests := []struct {
+		name       string
+		accountSid string
+		signToken  string
+		wantErr    bool
+	}{
+		{name: "First account", accountSid: "AC1", signToken: "token1", wantErr: false},
+		{name: "Second account", accountSid: "AC2", signToken: "token2", wantErr: false},
+		{name: "Token of other account", accountSid: "AC1", signToken: "token2", wantErr: true},
+		{name: "Unknown account", accountSid: "AC3", signToken: "token1", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			form := url.Values{"AccountSid": {tt.accountSid}, "From": {"+18005642365"}}
+			r := httptest.NewRequest(http.MethodPost, "/voice", strings.NewReader(form.Encode()))
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			if err := SignRequest(r, "https://example.com/voice", tt.signToken); err != nil {
+				t.Fatalf("SignRequest() error = %v", err)
+			}
+
+			req := NewRequest("https://example.com", r)
+			if err := req.ValidatePostFunc(ctx, lookup); (err != nil) != tt.wantErr {
+				t.Errorf("Request.ValidatePostFunc() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && req.Values["AccountSid"] != tt.accountSid {
+				t.Errorf("Request.Values[AccountSid] = %v, want %v", req.Values["AccountSid"], tt.accountSid)
+			}
+		})
+	}
+}
@@ -6,7 +6,14 @@ import (
 	"encoding/xml"
 	"fmt"
 	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
+	"unicode"
 
 	"github.com/go-playground/errors/v5"
 	"go.opencensus.io/trace"
@@ -23,6 +30,13 @@ const (
 	Get MethodType = "GET"
 )
 
+// MarshalXMLAttr encodes the method in upper case, as Twilio is case sensitive and rejects
+// "post" or "get". Methods other than GET and POST are still rendered, and are reported by
+// Validate, which also reports methods which are not upper case so the typo can be fixed.
+func (m MethodType) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: strings.ToUpper(string(m))}, nil
+}
+
 // TrackType is an enum for the track type
 type TrackType string
 
@@ -37,80 +51,228 @@ const (
 	BothTracks TrackType = "both_tracks"
 )
 
+// InputType is an enum for the Gather input modes
+type InputType string
+
+const (
+	// DTMFInput gathers digits pressed on the keypad
+	DTMFInput InputType = "dtmf"
+
+	// SpeechInput gathers speech, transcribed by Twilio
+	SpeechInput InputType = "speech"
+)
+
 // Response represents the TwiML Response Verb
+//
+// A Response and its verbs are builders which are not safe for concurrent use, as each
+// method appends to or sets fields on the shared verb tree. To customize a template
+// Response from many goroutines, each goroutine must build on its own copy from Clone. A
+// Response which is finished can be shared between goroutines in its rendered form from Freeze.
 type Response struct {
-	Verbs []interface{}
+	Verbs []Verb
+
+	validate      bool
+	defaultVoice  VoiceType
+	defaultMethod MethodType
 }
 
-// NewResponse returns a Response
-func NewResponse() *Response {
-	return &Response{}
+// ResponseOption sets a default of a Response, which is applied to each verb added to it
+type ResponseOption func(r *Response)
+
+// WithDefaultVoice sets the voice of each Say added to the Response without a voice,
+// including a Say nested in a verb such as Gather
+func WithDefaultVoice(voice VoiceType) ResponseOption {
+	return func(r *Response) {
+		r.defaultVoice = voice
+	}
 }
 
-// Gather adds the Gather verb to the response
-func (r *Response) Gather(gather *Gather) *Response {
-	r.Verbs = append(r.Verbs, gather)
+// WithDefaultMethod sets the method of each action, callback and Redirect URL added to the
+// Response without a method
+func WithDefaultMethod(method MethodType) ResponseOption {
+	return func(r *Response) {
+		r.defaultMethod = method
+	}
+}
+
+// NewResponse returns a Response with the given defaults. Defaults only fill in attributes
+// which are unset when a verb is added, so an attribute set on a verb takes precedence.
+func NewResponse(opts ...ResponseOption) *Response {
+	r := &Response{}
+	for _, opt := range opts {
+		opt(r)
+	}
 
 	return r
 }
 
-// Dial adds the dial verb to the response
-func (r *Response) Dial(dial *Dial) *Response {
-	r.Verbs = append(r.Verbs, dial)
+// NewValidatingResponse returns a Response which is validated each time it is rendered, so
+// that Render and Stream return the error from Validate instead of TwiML which is invalid
+func NewValidatingResponse(opts ...ResponseOption) *Response {
+	r := NewResponse(opts...)
+	r.validate = true
+
+	return r
+}
+
+// add appends verbs to the Response, after applying its defaults to them and the verbs
+// nested within them
+func (r *Response) add(verbs ...Verb) *Response {
+	walkVerbs(verbs, func(v Verb) {
+		if say, ok := v.(*Say); ok && say.Voice == "" {
+			say.Voice = r.defaultVoice
+		}
+		if r.defaultMethod == "" {
+			return
+		}
+		for _, attr := range urlAttrs(v) {
+			if attr.method != nil && *attr.method == "" && *attr.value != "" {
+				*attr.method = r.defaultMethod
+			}
+		}
+	})
+	r.Verbs = append(r.Verbs, verbs...)
 
 	return r
 }
 
+// Gather adds the Gather verb to the response
+func (r *Response) Gather(gather *Gather) *Response {
+	return r.add(gather)
+}
+
+// Dial adds the dial verb to the response
+func (r *Response) Dial(dial *Dial) *Response {
+	return r.add(dial)
+}
+
 // Say adds the say verb to the Response
 func (r *Response) Say(say *Say) *Response {
-	r.Verbs = append(r.Verbs, say)
+	return r.add(say)
+}
+
+// SayLong adds text to the Response as a Say verb in the voice, split across as many Say
+// verbs as needed to stay under the length Twilio will say, which otherwise truncates it.
+// Text is split at the end of a sentence where possible, then between words, and only within
+// a word when one is longer than the limit. Empty text adds nothing.
+func (r *Response) SayLong(text string, voice VoiceType) *Response {
+	for _, part := range splitSayText(text, maxSayLength) {
+		r.add(NewSay(part).SetVoice(voice))
+	}
 
 	return r
 }
 
 // Play adds the play verb to the Response
 func (r *Response) Play(play *Play) *Response {
-	r.Verbs = append(r.Verbs, play)
-
-	return r
+	return r.add(play)
 }
 
 // Start adds the start verb to the Response
 func (r *Response) Start(start *Start) *Response {
-	r.Verbs = append(r.Verbs, start)
+	return r.add(start)
+}
 
-	return r
+// Connect adds the connect verb to the Response
+func (r *Response) Connect(connect *Connect) *Response {
+	return r.add(connect)
 }
 
 // Pause appends a Pause verb to Dial
 func (r *Response) Pause(length uint) *Response {
-	r.Verbs = append(r.Verbs, NewPause(length))
+	return r.add(NewPause(length))
+}
 
-	return r
+// Enqueue adds the enqueue verb to the Response
+func (r *Response) Enqueue(enqueue *Enqueue) *Response {
+	return r.add(enqueue)
+}
+
+// Reject adds the reject verb to the Response
+func (r *Response) Reject(reject *Reject) *Response {
+	return r.add(reject)
+}
+
+// RejectBusy adds a Reject verb to the Response which plays a busy signal. The status
+// callback of the call then has a CallStatus of CallStatusBusy.
+func (r *Response) RejectBusy() *Response {
+	return r.Reject(NewReject().SetReason(BusyReason))
+}
+
+// RejectRejected adds a Reject verb to the Response which plays a not in service message.
+// The status callback of the call then has a CallStatus of CallStatusBusy, as Twilio
+// reports every rejected call as busy.
+func (r *Response) RejectRejected() *Response {
+	return r.Reject(NewReject().SetReason(RejectedReason))
+}
+
+// Record adds the record verb to the Response
+func (r *Response) Record(record *Record) *Response {
+	return r.add(record)
 }
 
 // Redirect appends a Redirect verb to Response
 func (r *Response) Redirect(redirect *Redirect) *Response {
-	r.Verbs = append(r.Verbs, redirect)
+	return r.add(redirect)
+}
+
+// Clear removes all verbs from the Response
+func (r *Response) Clear() *Response {
+	r.Verbs = nil
+
+	return r
+}
+
+// AppendResponse appends a copy of the verbs of other to the Response, so that a Response
+// can be composed from parts built separately. Changes made to other afterwards do not
+// affect the Response.
+func (r *Response) AppendResponse(other *Response) *Response {
+	if other == nil {
+		return r
+	}
+	return r.add(other.Clone().Verbs...)
+}
+
+// RemoveVerb removes the verb at index i from the Response. An index out of range is ignored.
+func (r *Response) RemoveVerb(i int) *Response {
+	r.Verbs = removeVerb(r.Verbs, i)
 
 	return r
 }
 
+// Raw adds pre-rendered TwiML to the Response, which is written verbatim. See RawXML.
+func (r *Response) Raw(raw []byte) *Response {
+	return r.add(RawXML(raw))
+}
+
 // Render returns the rendered twiml response
 func (r *Response) Render(ctx context.Context) ([]byte, error) {
-	_, span := trace.StartSpan(ctx, "twiml.Response.Render()")
+	ctx, span := trace.StartSpan(ctx, "twiml.Response.Render()")
 	defer span.End()
 
-	buff := new(bytes.Buffer)
-	buff.WriteString(xml.Header)
-	enc := xml.NewEncoder(buff)
-	enc.Indent("", "  ")
-	if err := enc.Encode(r); err != nil {
-		return nil, errors.Wrap(err, "xml.Encoder.Encode()")
+	buff, ok := bufferPool.Get().(*bytes.Buffer)
+	if !ok {
+		buff = new(bytes.Buffer)
+	}
+	defer putBuffer(buff)
+
+	if r.validate {
+		if err := r.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := r.encode(buff, func() {}); err != nil {
+		return nil, err
 	}
 	span.AddAttributes(trace.StringAttribute("twiml", buff.String()))
 
-	return buff.Bytes(), nil
+	res := bytes.Clone(buff.Bytes())
+	if hook := renderHook.Load(); hook != nil {
+		(*hook)(ctx, res)
+	}
+
+	return res, nil
 }
 
 // RenderTo writes the Rendered TwiML to the writer
@@ -130,20 +292,286 @@ func (r *Response) RenderTo(ctx context.Context, w io.Writer) error {
 	return nil
 }
 
+// WriteHTTP writes the rendered TwiML as the body of an HTTP response. The body is rendered
+// in full first, so that Content-Length can be set instead of the response being chunked,
+// which some proxies mangle. Content-Type is set unless it has already been set. Use Stream
+// to write the TwiML as it is rendered instead.
+func (r *Response) WriteHTTP(ctx context.Context, w http.ResponseWriter) error {
+	ctx, span := trace.StartSpan(ctx, "twiml.Response.WriteHTTP()")
+	defer span.End()
+
+	res, err := r.Render(ctx)
+	if err != nil {
+		return err
+	}
+
+	if w.Header().Get("Content-Type") == "" {
+		w.Header().Set("Content-Type", "text/xml; charset=utf-8")
+	}
+	w.Header().Set("Content-Length", strconv.Itoa(len(res)))
+	if _, err := w.Write(res); err != nil {
+		return errors.Wrap(err, "http.ResponseWriter.Write()")
+	}
+
+	return nil
+}
+
+// Stream writes the rendered TwiML to the writer one verb at a time, instead of buffering
+// the entire response first. If the writer is an http.Flusher, it is flushed after each verb.
+func (r *Response) Stream(ctx context.Context, w io.Writer) error {
+	_, span := trace.StartSpan(ctx, "twiml.Response.Stream()")
+	defer span.End()
+
+	if r.validate {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+	}
+
+	flush := func() {}
+	if flusher, ok := w.(http.Flusher); ok {
+		flush = flusher.Flush
+	}
+
+	if err := r.encode(w, flush); err != nil {
+		return err
+	}
+	flush()
+
+	return nil
+}
+
+// encode writes the indented TwiML document to w, calling flush after each verb. Each verb
+// is encoded on its own so that RawXML verbs can be written to w verbatim.
+func (r *Response) encode(w io.Writer, flush func()) error {
+	if _, err := io.WriteString(w, xml.Header+"<Response>"); err != nil {
+		return errors.Wrap(err, "io.WriteString()")
+	}
+	for _, v := range r.Verbs {
+		if raw, ok := v.(RawXML); ok {
+			if _, err := io.WriteString(w, "\n  "+string(raw)); err != nil {
+				return errors.Wrap(err, "io.WriteString()")
+			}
+			flush()
+
+			continue
+		}
+
+		if _, err := io.WriteString(w, "\n"); err != nil {
+			return errors.Wrap(err, "io.WriteString()")
+		}
+		enc := xml.NewEncoder(w)
+		enc.Indent("  ", "  ")
+		if err := enc.Encode(v); err != nil {
+			return errors.Wrap(err, "xml.Encoder.Encode()")
+		}
+		flush()
+	}
+	end := "</Response>"
+	if len(r.Verbs) > 0 {
+		end = "\n" + end
+	}
+	if _, err := io.WriteString(w, end); err != nil {
+		return errors.Wrap(err, "io.WriteString()")
+	}
+
+	return nil
+}
+
+// Validate checks the verbs in the Response for TwiML which is invalid or is almost
+// certainly a bug, including verbs nested where TwiML does not allow them. All problems
+// found are returned joined together.
+func (r *Response) Validate() error {
+	err := errors.Join(append(validateNesting("Response", r.Verbs), validateVerbs(r.Verbs)...)...)
+	if err != nil {
+		if hook := validationHook.Load(); hook != nil {
+			(*hook)(context.Background(), err)
+		}
+	}
+
+	return err
+}
+
+// Warnings returns problems with the verbs in the Response which are valid TwiML, but are
+// likely a mistake. Unlike Validate, these do not need to be fixed for Twilio to accept the
+// Response.
+func (r *Response) Warnings() []string {
+	var warnings []string
+	if len(r.Verbs) == 0 {
+		warnings = append(warnings, "twiml.Response.Warnings(): Response has no verbs, so the call is hung up")
+	}
+	walkVerbs(r.Verbs, func(v Verb) {
+		if w, ok := v.(warner); ok {
+			warnings = append(warnings, w.Warnings()...)
+		}
+		warnings = append(warnings, methodWarnings(v)...)
+	})
+	warnings = append(warnings, originWarnings(r.Verbs)...)
+	warnings = append(warnings, unreachableWarnings(r.Verbs)...)
+
+	return warnings
+}
+
+// unreachableWarnings reports each verb after the first Redirect, Hangup or Reject, as Twilio
+// stops executing the Response there, so any verbs which follow are never reached
+func unreachableWarnings(verbs []Verb) []string {
+	for i, v := range verbs {
+		switch v.(type) {
+		case *Redirect, *Hangup, *Reject:
+		default:
+			continue
+		}
+
+		var warnings []string
+		for j, next := range verbs[i+1:] {
+			warnings = append(warnings, fmt.Sprintf("twiml.Response.Warnings(): %s %d follows %s %d, so it is never reached", verbName(next), i+1+j, verbName(v), i))
+		}
+
+		return warnings
+	}
+
+	return nil
+}
+
+// ValidateStrict checks the Response like Validate, but also treats each of its Warnings as
+// an error, for callers which never intend to send TwiML the warnings apply to, such as an
+// empty Response
+func (r *Response) ValidateStrict() error {
+	errs := []error{r.Validate()}
+	for _, w := range r.Warnings() {
+		errs = append(errs, errors.New(w))
+	}
+
+	return errors.Join(errs...)
+}
+
+// validator is implemented by verbs which can check their own configuration
+type validator interface {
+	Validate() error
+}
+
+// warner is implemented by verbs which can report likely mistakes in their configuration
+type warner interface {
+	Warnings() []string
+}
+
+// validateVerbs validates each verb, and the verbs nested within it
+func validateVerbs(verbs []Verb) []error {
+	var errs []error
+	for _, v := range verbs {
+		if val, ok := v.(validator); ok {
+			if err := val.Validate(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		// Gather.Validate checks its own methods, so they are not reported twice
+		if _, ok := v.(*Gather); !ok {
+			errs = append(errs, validateMethods(v)...)
+		}
+		errs = append(errs, validateCallbackURLs(v)...)
+		errs = append(errs, validateVerbs(nestedVerbs(v))...)
+	}
+
+	return errs
+}
+
+// removeVerb returns verbs without the verb at index i, or verbs unchanged if i is out of range
+func removeVerb(verbs []Verb, i int) []Verb {
+	if i < 0 || i >= len(verbs) {
+		return verbs
+	}
+
+	return slices.Delete(verbs, i, i+1)
+}
+
+// nestedVerbs returns the verbs nested within v
+func nestedVerbs(v Verb) []Verb {
+	switch v := v.(type) {
+	case *Dial:
+		return v.Verbs
+	case *Gather:
+		return v.Verbs
+	case *Start:
+		return v.Verbs
+	case *Connect:
+		return v.Verbs
+	case *Stream:
+		return v.Verbs
+	case *Application:
+		return v.Verbs
+	case *Client:
+		return v.Verbs
+	case *Refer:
+		return v.Verbs
+	case *ConversationRelay:
+		return v.Verbs
+	}
+
+	return nil
+}
+
+// Refer adds the Refer verb to the Response
+func (r *Response) Refer(refer *Refer) *Response {
+	return r.add(refer)
+}
+
 // Hangup adds the hangup verb to the Response
 func (r *Response) Hangup() *Response {
-	r.Verbs = append(r.Verbs, &Hangup{})
-
-	return r
+	return r.add(&Hangup{})
 }
 
+// RecordType is an enum for the Dial record attribute
+type RecordType string
+
+const (
+	// DoNotRecord disables recording
+	DoNotRecord RecordType = "do-not-record"
+
+	// RecordFromAnswer records from when the call is answered
+	RecordFromAnswer RecordType = "record-from-answer"
+
+	// RecordFromRinging records from when the call starts ringing
+	RecordFromRinging RecordType = "record-from-ringing"
+
+	// RecordFromAnswerDual records from when the call is answered, with each leg on a separate channel
+	RecordFromAnswerDual RecordType = "record-from-answer-dual"
+
+	// RecordFromRingingDual records from when the call starts ringing, with each leg on a separate channel
+	RecordFromRingingDual RecordType = "record-from-ringing-dual"
+)
+
+// RecordingTrackType is an enum for the Dial recordingTrack attribute, which selects the
+// audio recorded relative to the parent call
+type RecordingTrackType string
+
+const (
+	// InboundRecordingTrack records the audio received from the caller
+	InboundRecordingTrack RecordingTrackType = "inbound"
+
+	// OutboundRecordingTrack records the audio sent to the caller
+	OutboundRecordingTrack RecordingTrackType = "outbound"
+
+	// BothRecordingTracks records the audio of both tracks, which is Twilio's default
+	BothRecordingTracks RecordingTrackType = "both"
+)
+
 // Dial represents the TwiML Dial Verb
 type Dial struct {
-	XMLName xml.Name   `xml:"Dial"`
-	Action  string     `xml:"action,attr,omitempty"`
-	Method  MethodType `xml:"method,attr,omitempty"`
-	Timeout uint       `xml:"timeout,attr,omitempty"`
-	Verbs   []interface{}
+	XMLName                       xml.Name           `xml:"Dial"`
+	Action                        string             `xml:"action,attr,omitempty"`
+	Method                        MethodType         `xml:"method,attr,omitempty"`
+	Timeout                       uint               `xml:"timeout,attr,omitempty"`
+	AnswerOnBridge                *bool              `xml:"answerOnBridge,attr"`
+	Record                        RecordType         `xml:"record,attr,omitempty"`
+	RecordingTrack                RecordingTrackType `xml:"recordingTrack,attr,omitempty"`
+	RecordingStatusCallback       string             `xml:"recordingStatusCallback,attr,omitempty"`
+	RecordingStatusCallbackMethod MethodType         `xml:"recordingStatusCallbackMethod,attr,omitempty"`
+	Extra                         []xml.Attr         `xml:",any,attr"`
+	Verbs                         []Verb
+
+	// subSecondTimeout holds a timeout under a second given to SetTimeoutDuration, which
+	// Validate reports rather than the second it was rounded up to
+	subSecondTimeout time.Duration
 }
 
 // NewDial returns a Dial verb
@@ -151,6 +579,92 @@ func NewDial() *Dial {
 	return &Dial{}
 }
 
+// AddAttr adds an unmodeled attribute to the Dial, for attributes Twilio has added since
+// this package was released. Like the AddAttr method of every verb and noun, it ignores the
+// names of modeled attributes, as modeled attributes take precedence.
+func (d *Dial) AddAttr(name, value string) *Dial {
+	d.Extra = addAttr(d.Extra, d, name, value)
+
+	return d
+}
+
+// SetAction sets the action attribute
+func (d *Dial) SetAction(action string) *Dial {
+	d.Action = action
+
+	return d
+}
+
+// SetMethod sets the method attribute
+func (d *Dial) SetMethod(method MethodType) *Dial {
+	d.Method = method
+
+	return d
+}
+
+// SetTimeout sets the timeout attribute, in seconds. A timeout of zero leaves the attribute
+// unset, which is Twilio's default of 30 seconds, as timeout="0" can not be rendered.
+func (d *Dial) SetTimeout(timeout uint) *Dial {
+	d.Timeout = timeout
+	d.subSecondTimeout = 0
+
+	return d
+}
+
+// SetTimeoutDuration sets the timeout attribute from a duration, rounded to the nearest
+// whole second. A duration under a second is rounded up to one second rather than down to
+// zero, which would silently leave Twilio's default, and Validate reports the duration given.
+func (d *Dial) SetTimeoutDuration(timeout time.Duration) *Dial {
+	d.Timeout = durationToSeconds(timeout)
+	d.subSecondTimeout = subSecond(timeout)
+
+	return d
+}
+
+// SetAnswerOnBridge sets the answerOnBridge attribute. When true, the caller hears ringing
+// and is not answered, or billed, until the dialed party answers.
+func (d *Dial) SetAnswerOnBridge(answerOnBridge bool) *Dial {
+	d.AnswerOnBridge = &answerOnBridge
+
+	return d
+}
+
+// SetRecord sets the record attribute
+func (d *Dial) SetRecord(record RecordType) *Dial {
+	d.Record = record
+
+	return d
+}
+
+// SetRecordingStatusCallback sets the recordingStatusCallback attribute
+func (d *Dial) SetRecordingStatusCallback(recordingStatusCallback string) *Dial {
+	d.RecordingStatusCallback = recordingStatusCallback
+
+	return d
+}
+
+// SetRecordingTrack sets the recordingTrack attribute
+func (d *Dial) SetRecordingTrack(recordingTrack RecordingTrackType) *Dial {
+	d.RecordingTrack = recordingTrack
+
+	return d
+}
+
+// SetRecordingStatusCallbackMethod sets the recordingStatusCallbackMethod attribute
+func (d *Dial) SetRecordingStatusCallbackMethod(recordingStatusCallbackMethod MethodType) *Dial {
+	d.RecordingStatusCallbackMethod = recordingStatusCallbackMethod
+
+	return d
+}
+
+// RecordDualChannel records the call from answer with each leg on a separate channel,
+// sending recording status to callbackURL using POST
+func (d *Dial) RecordDualChannel(callbackURL string) *Dial {
+	return d.SetRecord(RecordFromAnswerDual).
+		SetRecordingStatusCallback(callbackURL).
+		SetRecordingStatusCallbackMethod(Post)
+}
+
 // Number appends a Number verb to Dial
 func (d *Dial) Number(number *Number) *Dial {
 	d.Verbs = append(d.Verbs, number)
@@ -158,6 +672,37 @@ func (d *Dial) Number(number *Number) *Dial {
 	return d
 }
 
+// Application appends an Application noun to Dial
+func (d *Dial) Application(application *Application) *Dial {
+	d.Verbs = append(d.Verbs, application)
+
+	return d
+}
+
+// Client appends a Client noun to Dial
+func (d *Dial) Client(client *Client) *Dial {
+	d.Verbs = append(d.Verbs, client)
+
+	return d
+}
+
+// Numbers appends a Number verb to Dial for each number, in order, so that all of them are
+// dialed at once and the first to answer is connected
+func (d *Dial) Numbers(numbers ...string) *Dial {
+	for _, n := range numbers {
+		d.Verbs = append(d.Verbs, NewNumber(n))
+	}
+
+	return d
+}
+
+// Sip appends a Sip noun to Dial
+func (d *Dial) Sip(sip *Sip) *Dial {
+	d.Verbs = append(d.Verbs, sip)
+
+	return d
+}
+
 // Conference appends a Conference verb to Dial
 func (d *Dial) Conference(conference *Conference) *Dial {
 	d.Verbs = append(d.Verbs, conference)
@@ -165,6 +710,48 @@ func (d *Dial) Conference(conference *Conference) *Dial {
 	return d
 }
 
+// Clear removes all nouns from the Dial
+func (d *Dial) Clear() *Dial {
+	d.Verbs = nil
+
+	return d
+}
+
+// RemoveVerb removes the noun at index i from the Dial. An index out of range is ignored.
+func (d *Dial) RemoveVerb(i int) *Dial {
+	d.Verbs = removeVerb(d.Verbs, i)
+
+	return d
+}
+
+// Validate checks that the Dial contains a single noun, since Twilio can only dial one
+// thing at a time. Several Numbers are the exception, as they are dialed simultaneously
+// and the first to answer is connected. It also checks the timeout is within the 5 to 600
+// seconds Twilio allows.
+func (d *Dial) Validate() error {
+	var errs []error
+
+	if err := validateSeconds("timeout", d.Timeout, d.subSecondTimeout, 5, 600); err != nil {
+		errs = append(errs, fmt.Errorf("twiml.Dial.Validate(): %w", err))
+	}
+
+	var nouns []string
+	for _, v := range d.Verbs {
+		if _, ok := v.(RawXML); !ok {
+			nouns = append(nouns, verbName(v))
+		}
+	}
+	for _, noun := range nouns {
+		if len(nouns) > 1 && noun != "Number" {
+			errs = append(errs, fmt.Errorf("twiml.Dial.Validate(): Dial may contain one noun, or several Numbers, found %s", strings.Join(nouns, ", ")))
+
+			break
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
 // VoiceType is enum type for voice
 type VoiceType string
 
@@ -190,12 +777,17 @@ const (
 	PollyMatthew VoiceType = "Polly.Matthew"
 )
 
-// Say represents the TwiML Say verb
+// Say represents the TwiML Say verb. Twilio provides no speech marks or visemes for Say, or
+// for ConversationRelay, so speech which must be synchronized, such as with the lips of an
+// avatar, is generated by the application and played over a bidirectional Stream, where
+// marks report when each part has played.
 type Say struct {
-	XMLName xml.Name  `xml:"Say"`
-	Voice   VoiceType `xml:"voice,attr,omitempty"`
-	Loop    uint      `xml:"loop,attr,omitempty"`
-	Value   string    `xml:",chardata"`
+	XMLName xml.Name   `xml:"Say"`
+	Voice   VoiceType  `xml:"voice,attr,omitempty"`
+	Loop    *uint      `xml:"loop,attr"`
+	Extra   []xml.Attr `xml:",any,attr"`
+	Value   string     `xml:",chardata"`
+	SSML    string     `xml:",innerxml"`
 }
 
 // NewSay returns a Say verb
@@ -203,6 +795,13 @@ func NewSay(msg string) *Say {
 	return &Say{Value: msg}
 }
 
+// AddAttr adds an unmodeled attribute to the Say
+func (s *Say) AddAttr(name, value string) *Say {
+	s.Extra = addAttr(s.Extra, s, name, value)
+
+	return s
+}
+
 // SetVoice sets the voice value
 func (s *Say) SetVoice(voice VoiceType) *Say {
 	s.Voice = voice
@@ -210,61 +809,418 @@ func (s *Say) SetVoice(voice VoiceType) *Say {
 	return s
 }
 
-// SetLoop sets the Loop value
+// SetLoop sets the number of times to repeat. When unset, Twilio says it once, while a
+// loop of zero repeats it until the call ends (see SetInfiniteLoop).
 func (s *Say) SetLoop(loop uint) *Say {
-	s.Loop = loop
+	s.Loop = &loop
 
 	return s
 }
 
-// Number represents a phone number to call
-type Number struct {
-	XMLName xml.Name `xml:"Number"`
-	Value   string   `xml:",chardata"`
+// SetInfiniteLoop repeats the Say until the call ends, by setting loop to zero
+func (s *Say) SetInfiniteLoop() *Say {
+	return s.SetLoop(0)
 }
 
-// NewNumber returns a Number verb
-func NewNumber(number string) *Number {
-	return &Number{Value: number}
-}
+// SetValue sets the text to say, which is escaped when rendered. Any SSML is cleared.
+func (s *Say) SetValue(value string) *Say {
+	s.Value = value
+	s.SSML = ""
 
-// Gather represents the TwiML Gather verb
-type Gather struct {
-	XMLName                     xml.Name   `xml:"Gather"`
-	Input                       string     `xml:"input,attr,omitempty"`
-	Action                      string     `xml:"action,attr,omitempty"`
-	Method                      MethodType `xml:"method,attr,omitempty"`
-	Timeout                     uint       `xml:"timeout,attr,omitempty"`
-	FinishOnKey                 *string    `xml:"finishOnKey,attr"`
-	NumDigits                   uint       `xml:"numDigits,attr,omitempty"`
-	PartialResultCallback       string     `xml:"partialResultCallback,attr,omitempty"`
-	PartialResultCallbackMethod MethodType `xml:"partialResultCallbackMethod,attr,omitempty"`
-	Language                    string     `xml:"language,attr,omitempty"`
-	Hints                       string     `xml:"hints,attr,omitempty"`
-	ProfanityFilter             bool       `xml:"profanityFilter,attr,omitempty"`
-	SpeechTimeout               uint       `xml:"speechTimeout,attr,omitempty"`
-	Verbs                       []interface{}
+	return s
 }
 
-// NewGather returns a Gather verb
-func NewGather() *Gather {
-	return &Gather{}
+// SetSSML sets the content of the Say to SSML markup, such as `Hello <break time="1s"/> world`,
+// which is rendered as is instead of being escaped. Any text set with SetValue is cleared.
+// Rendering fails if the SSML is not well-formed XML.
+//
+// The SSML is otherwise trusted completely, so never build it from untrusted input, as it
+// can inject arbitrary TwiML into the call.
+func (s *Say) SetSSML(ssml string) *Say {
+	s.SSML = ssml
+	s.Value = ""
+
+	return s
 }
 
-// Say appends a Say verb to Gather
-func (g *Gather) Say(say *Say) *Gather {
-	g.Verbs = append(g.Verbs, say)
+// Prosody sets the content of the Say to text wrapped in an SSML prosody element, such as
+// <prosody rate="slow">text</prosody>, to slow down or emphasize it without writing SSML by
+// hand. Attributes left empty are omitted, but at least one must be given, or Validate fails.
+//
+//   - rate is x-slow, slow, medium, fast, x-fast, or a percentage of the default such as 80%
+//   - pitch is x-low, low, medium, high, x-high, or a relative change such as +10% or -5%
+//   - volume is silent, x-soft, soft, medium, loud, x-loud, or a change in decibels such as +6dB
+//
+// The text is escaped, so unlike SetSSML it may come from untrusted input. Any text set with
+// SetValue or SetSSML is cleared.
+func (s *Say) Prosody(rate, pitch, volume, text string) *Say {
+	var b strings.Builder
+	b.WriteString("<prosody")
+	for _, attr := range [][2]string{{"rate", rate}, {"pitch", pitch}, {"volume", volume}} {
+		if attr[1] == "" {
+			continue
+		}
+		b.WriteString(" " + attr[0] + `="`)
+		_ = xml.EscapeText(&b, []byte(attr[1]))
+		b.WriteString(`"`)
+	}
+	b.WriteString(">")
+	_ = xml.EscapeText(&b, []byte(text))
+	b.WriteString("</prosody>")
 
-	return g
+	return s.SetSSML(b.String())
 }
 
-// Pause appends a Pause verb to Gather
-func (g *Gather) Pause(length uint) *Gather {
+// MarshalXML encodes the Say, checking that any SSML is well-formed first
+func (s *Say) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if s.SSML != "" {
+		if err := validateSSML(s.SSML); err != nil {
+			return errors.Wrap(err, "twiml.Say.MarshalXML()")
+		}
+	}
+
+	type say Say
+	start.Name = xml.Name{Local: "Say"}
+	if err := e.EncodeElement((*say)(s), start); err != nil {
+		return errors.Wrap(err, "xml.Encoder.EncodeElement()")
+	}
+
+	return nil
+}
+
+// Validate checks that the Say has text or SSML to say, as an empty Say is usually a prompt
+// which was never filled in, and that each prosody element in the SSML has an attribute
+func (s *Say) Validate() error {
+	if strings.TrimSpace(s.Value) == "" && strings.TrimSpace(s.SSML) == "" {
+		return fmt.Errorf("twiml.Say.Validate(): Say has nothing to say")
+	}
+
+	if ssmlHasEmptyProsody(s.SSML) {
+		return fmt.Errorf("twiml.Say.Validate(): prosody must set at least one of rate, pitch or volume")
+	}
+
+	return nil
+}
+
+// MachineDetectionType is an enum for the machineDetection attribute, which enables
+// answering machine detection (AMD) on the call
+type MachineDetectionType string
+
+const (
+	// EnableMachineDetection reports whether a human or machine answered as soon as it is known
+	EnableMachineDetection MachineDetectionType = "Enable"
+
+	// DetectMessageEndMachineDetection waits for the end of the greeting when a machine
+	// answered, so that a message can be left after the beep
+	DetectMessageEndMachineDetection MachineDetectionType = "DetectMessageEnd"
+)
+
+// maxSayLength is the most characters SayLong puts in one Say, leaving a margin under the
+// 4,096 characters Twilio will say
+const maxSayLength = 4000
+
+// splitSayText splits text into parts of at most limit characters, preferring to split at the
+// end of a sentence, then at whitespace
+func splitSayText(text string, limit int) []string {
+	var parts []string
+	rest := []rune(strings.TrimSpace(text))
+	for len(rest) > limit {
+		window := rest[:limit+1]
+		split := -1
+		for i := len(window) - 1; i > 0; i-- {
+			if unicode.IsSpace(window[i]) && strings.ContainsRune(".!?", window[i-1]) {
+				split = i
+
+				break
+			}
+		}
+		if split < 0 {
+			for i := len(window) - 1; i > 0; i-- {
+				if unicode.IsSpace(window[i]) {
+					split = i
+
+					break
+				}
+			}
+		}
+		if split < 0 {
+			split = limit
+		}
+
+		parts = append(parts, strings.TrimSpace(string(rest[:split])))
+		rest = []rune(strings.TrimLeftFunc(string(rest[split:]), unicode.IsSpace))
+	}
+	if len(rest) > 0 {
+		parts = append(parts, string(rest))
+	}
+
+	return parts
+}
+
+// Number represents a phone number to call
+type Number struct {
+	XMLName                            xml.Name             `xml:"Number"`
+	URL                                string               `xml:"url,attr,omitempty"`
+	Method                             MethodType           `xml:"method,attr,omitempty"`
+	StatusCallbackEvent                string               `xml:"statusCallbackEvent,attr,omitempty"`
+	StatusCallback                     string               `xml:"statusCallback,attr,omitempty"`
+	StatusCallbackMethod               MethodType           `xml:"statusCallbackMethod,attr,omitempty"`
+	MachineDetection                   MachineDetectionType `xml:"machineDetection,attr,omitempty"`
+	MachineDetectionTimeout            uint                 `xml:"machineDetectionTimeout,attr,omitempty"`
+	MachineDetectionSpeechThreshold    uint                 `xml:"machineDetectionSpeechThreshold,attr,omitempty"`
+	MachineDetectionSpeechEndThreshold uint                 `xml:"machineDetectionSpeechEndThreshold,attr,omitempty"`
+	MachineDetectionSilenceTimeout     uint                 `xml:"machineDetectionSilenceTimeout,attr,omitempty"`
+	AmdStatusCallback                  string               `xml:"amdStatusCallback,attr,omitempty"`
+	AmdStatusCallbackMethod            MethodType           `xml:"amdStatusCallbackMethod,attr,omitempty"`
+	Extra                              []xml.Attr           `xml:",any,attr"`
+	Value                              string               `xml:",chardata"`
+}
+
+// NewNumber returns a Number verb
+func NewNumber(number string) *Number {
+	return &Number{Value: number}
+}
+
+// AddAttr adds an unmodeled attribute to the Number
+func (n *Number) AddAttr(name, value string) *Number {
+	n.Extra = addAttr(n.Extra, n, name, value)
+
+	return n
+}
+
+// SetURL sets the url attribute, the TwiML run for the called party before they are
+// connected, such as a whisper announcing the caller. It may be relative to the webhook URL,
+// which Twilio and ResolveURLs resolve it against.
+func (n *Number) SetURL(rawURL string) *Number {
+	n.URL = rawURL
+
+	return n
+}
+
+// SetMethod sets the method attribute, used to request the url
+func (n *Number) SetMethod(method MethodType) *Number {
+	n.Method = method
+
+	return n
+}
+
+// SetStatusCallbackEvent sets the statusCallbackEvent attribute
+func (n *Number) SetStatusCallbackEvent(statusCallbackEvent DialCallbackEvent) *Number {
+	n.StatusCallbackEvent = string(statusCallbackEvent)
+
+	return n
+}
+
+// SetStatusCallback sets the statusCallback attribute
+func (n *Number) SetStatusCallback(statusCallback string) *Number {
+	n.StatusCallback = statusCallback
+
+	return n
+}
+
+// SetStatusCallbackMethod sets the statusCallbackMethod attribute
+func (n *Number) SetStatusCallbackMethod(statusCallbackMethod MethodType) *Number {
+	n.StatusCallbackMethod = statusCallbackMethod
+
+	return n
+}
+
+// SetMachineDetection sets the machineDetection attribute, enabling answering machine
+// detection on the dialed leg. The result is sent to the amdStatusCallback.
+func (n *Number) SetMachineDetection(machineDetection MachineDetectionType) *Number {
+	n.MachineDetection = machineDetection
+
+	return n
+}
+
+// SetMachineDetectionTimeout sets the machineDetectionTimeout attribute, in seconds, from 3
+// to 59. Twilio defaults to 30.
+func (n *Number) SetMachineDetectionTimeout(timeout uint) *Number {
+	n.MachineDetectionTimeout = timeout
+
+	return n
+}
+
+// SetMachineDetectionSpeechThreshold sets the machineDetectionSpeechThreshold attribute, in
+// milliseconds, from 1000 to 6000. Speech longer than this is taken to be a machine greeting.
+func (n *Number) SetMachineDetectionSpeechThreshold(threshold uint) *Number {
+	n.MachineDetectionSpeechThreshold = threshold
+
+	return n
+}
+
+// SetMachineDetectionSpeechEndThreshold sets the machineDetectionSpeechEndThreshold attribute,
+// in milliseconds, from 500 to 5000. Silence longer than this is taken as the end of speech.
+func (n *Number) SetMachineDetectionSpeechEndThreshold(threshold uint) *Number {
+	n.MachineDetectionSpeechEndThreshold = threshold
+
+	return n
+}
+
+// SetMachineDetectionSilenceTimeout sets the machineDetectionSilenceTimeout attribute, in
+// milliseconds, from 2000 to 10000. Initial silence longer than this gives an unknown result.
+func (n *Number) SetMachineDetectionSilenceTimeout(timeout uint) *Number {
+	n.MachineDetectionSilenceTimeout = timeout
+
+	return n
+}
+
+// SetAmdStatusCallback sets the amdStatusCallback attribute
+func (n *Number) SetAmdStatusCallback(amdStatusCallback string) *Number {
+	n.AmdStatusCallback = amdStatusCallback
+
+	return n
+}
+
+// SetAmdStatusCallbackMethod sets the amdStatusCallbackMethod attribute
+func (n *Number) SetAmdStatusCallbackMethod(amdStatusCallbackMethod MethodType) *Number {
+	n.AmdStatusCallbackMethod = amdStatusCallbackMethod
+
+	return n
+}
+
+// Validate checks that the Number is a valid phone number with a url which parses, and that
+// any answering machine detection settings are within the ranges Twilio accepts
+func (n *Number) Validate() error {
+	var errs []error
+	if err := validPhoneNumber(n.Value, ""); err != nil {
+		errs = append(errs, fmt.Errorf("twiml.Number.Validate(): %q: %w", n.Value, err))
+	}
+
+	if n.URL != "" {
+		if err := validateURL(n.URL); err != nil {
+			errs = append(errs, fmt.Errorf("twiml.Number.Validate(): url %q: %w", n.URL, err))
+		}
+	}
+
+	switch n.MachineDetection {
+	case "", EnableMachineDetection, DetectMessageEndMachineDetection:
+	default:
+		errs = append(errs, fmt.Errorf("twiml.Number.Validate(): invalid machineDetection %q", n.MachineDetection))
+	}
+
+	for _, r := range []struct {
+		name     string
+		value    uint
+		min, max uint
+	}{
+		{name: "machineDetectionTimeout", value: n.MachineDetectionTimeout, min: 3, max: 59},
+		{name: "machineDetectionSpeechThreshold", value: n.MachineDetectionSpeechThreshold, min: 1000, max: 6000},
+		{name: "machineDetectionSpeechEndThreshold", value: n.MachineDetectionSpeechEndThreshold, min: 500, max: 5000},
+		{name: "machineDetectionSilenceTimeout", value: n.MachineDetectionSilenceTimeout, min: 2000, max: 10000},
+	} {
+		if r.value != 0 && (r.value < r.min || r.value > r.max) {
+			errs = append(errs, fmt.Errorf("twiml.Number.Validate(): %s %d must be between %d and %d", r.name, r.value, r.min, r.max))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// initiated ringing answered completed
+type DialCallbackEvent string
+
+// DialCallbackEvents enables specific Callback Events for a dialed noun
+func DialCallbackEvents() DialCallbackEvent {
+	return DialCallbackEvent("")
+}
+
+// Initiated enables the Callback Event to indicate the call has been initiated
+func (d DialCallbackEvent) Initiated() DialCallbackEvent {
+	return DialCallbackEvent(strings.TrimLeft(fmt.Sprintf("%s initiated", d), " "))
+}
+
+// Ringing enables the Callback Event to indicate the call is ringing
+func (d DialCallbackEvent) Ringing() DialCallbackEvent {
+	return DialCallbackEvent(strings.TrimLeft(fmt.Sprintf("%s ringing", d), " "))
+}
+
+// Answered enables the Callback Event to indicate the call has been answered
+func (d DialCallbackEvent) Answered() DialCallbackEvent {
+	return DialCallbackEvent(strings.TrimLeft(fmt.Sprintf("%s answered", d), " "))
+}
+
+// Completed enables the Callback Event to indicate the call has completed
+func (d DialCallbackEvent) Completed() DialCallbackEvent {
+	return DialCallbackEvent(strings.TrimLeft(fmt.Sprintf("%s completed", d), " "))
+}
+
+// Gather represents the TwiML Gather verb
+type Gather struct {
+	XMLName                     xml.Name        `xml:"Gather"`
+	Input                       string          `xml:"input,attr,omitempty"`
+	Action                      string          `xml:"action,attr,omitempty"`
+	Method                      MethodType      `xml:"method,attr,omitempty"`
+	Timeout                     uint            `xml:"timeout,attr,omitempty"`
+	FinishOnKey                 *string         `xml:"finishOnKey,attr"`
+	NumDigits                   uint            `xml:"numDigits,attr,omitempty"`
+	PartialResultCallback       string          `xml:"partialResultCallback,attr,omitempty"`
+	PartialResultCallbackMethod MethodType      `xml:"partialResultCallbackMethod,attr,omitempty"`
+	Language                    string          `xml:"language,attr,omitempty"`
+	Hints                       string          `xml:"hints,attr,omitempty"`
+	SpeechModel                 SpeechModelType `xml:"speechModel,attr,omitempty"`
+	ProfanityFilter             *bool           `xml:"profanityFilter,attr"`
+	SpeechTimeout               string          `xml:"speechTimeout,attr,omitempty"`
+	Extra                       []xml.Attr      `xml:",any,attr"`
+	Verbs                       []Verb
+
+	// subSecondTimeout holds a timeout under a second given to SetTimeoutDuration, which
+	// Validate reports rather than the second it was rounded up to
+	subSecondTimeout time.Duration
+}
+
+// NewGather returns a Gather verb
+func NewGather() *Gather {
+	return &Gather{}
+}
+
+// NewSpeechGather returns a Gather verb which only listens for speech, ending when the caller
+// pauses, with the hints given as likely words and phrases. DTMF attributes such as numDigits
+// and finishOnKey are ignored by Twilio for speech input, so Warnings reports them if set.
+func NewSpeechGather(hints ...string) *Gather {
+	g := NewGather().SetInputModes(SpeechInput).SetSpeechTimeoutAuto()
+	if len(hints) > 0 {
+		g.SetHints(strings.Join(hints, ","))
+	}
+
+	return g
+}
+
+// AddAttr adds an unmodeled attribute to the Gather
+func (g *Gather) AddAttr(name, value string) *Gather {
+	g.Extra = addAttr(g.Extra, g, name, value)
+
+	return g
+}
+
+// Say appends a Say verb to Gather
+func (g *Gather) Say(say *Say) *Gather {
+	g.Verbs = append(g.Verbs, say)
+
+	return g
+}
+
+// Pause appends a Pause verb to Gather
+func (g *Gather) Pause(length uint) *Gather {
 	g.Verbs = append(g.Verbs, NewPause(length))
 
 	return g
 }
 
+// Clear removes all verbs from the Gather
+func (g *Gather) Clear() *Gather {
+	g.Verbs = nil
+
+	return g
+}
+
+// RemoveVerb removes the verb at index i from the Gather. An index out of range is ignored.
+func (g *Gather) RemoveVerb(i int) *Gather {
+	g.Verbs = removeVerb(g.Verbs, i)
+
+	return g
+}
+
 // SetInput sets the input attribute
 func (g *Gather) SetInput(input string) *Gather {
 	g.Input = input
@@ -272,6 +1228,19 @@ func (g *Gather) SetInput(input string) *Gather {
 	return g
 }
 
+// SetInputModes sets the input attribute to the given modes, joined in the order given.
+// Twilio listens for every mode at once, whichever the caller uses first, so the order
+// of the modes does not change which takes precedence.
+func (g *Gather) SetInputModes(modes ...InputType) *Gather {
+	m := make([]string, 0, len(modes))
+	for _, mode := range modes {
+		m = append(m, string(mode))
+	}
+	g.Input = strings.Join(m, " ")
+
+	return g
+}
+
 // SetAction sets the action attribute
 func (g *Gather) SetAction(action string) *Gather {
 	g.Action = action
@@ -279,7 +1248,9 @@ func (g *Gather) SetAction(action string) *Gather {
 	return g
 }
 
-// SetMethod sets the method attribute
+// SetMethod sets the method attribute, which Twilio defaults to POST when unset. Set Get for
+// an action which only navigates, so its requests are safe to repeat. A method other than GET
+// or POST, including one which is not upper case, is reported by Validate.
 func (g *Gather) SetMethod(method MethodType) *Gather {
 	g.Method = method
 
@@ -289,6 +1260,25 @@ func (g *Gather) SetMethod(method MethodType) *Gather {
 // SetTimeout sets the timeout attribute
 func (g *Gather) SetTimeout(timeout uint) *Gather {
 	g.Timeout = timeout
+	g.subSecondTimeout = 0
+
+	return g
+}
+
+// SetTimeoutDuration sets the timeout attribute from a duration, rounded to the nearest
+// whole second. A duration under a second is rounded up to one second, and Validate reports
+// the duration given.
+func (g *Gather) SetTimeoutDuration(timeout time.Duration) *Gather {
+	g.Timeout = durationToSeconds(timeout)
+	g.subSecondTimeout = subSecond(timeout)
+
+	return g
+}
+
+// SetFinishOnKey sets the finishOnKey attribute. An empty finishOnKey disables it, so no
+// key submits the digits entered.
+func (g *Gather) SetFinishOnKey(finishOnKey string) *Gather {
+	g.FinishOnKey = &finishOnKey
 
 	return g
 }
@@ -300,10 +1290,156 @@ func (g *Gather) SetNumDigits(numDigits uint) *Gather {
 	return g
 }
 
+// SetPartialResultCallback sets the partialResultCallback attribute
+func (g *Gather) SetPartialResultCallback(partialResultCallback string) *Gather {
+	g.PartialResultCallback = partialResultCallback
+
+	return g
+}
+
+// SetPartialResultCallbackMethod sets the partialResultCallbackMethod attribute
+func (g *Gather) SetPartialResultCallbackMethod(partialResultCallbackMethod MethodType) *Gather {
+	g.PartialResultCallbackMethod = partialResultCallbackMethod
+
+	return g
+}
+
+// SetLanguage sets the language attribute, such as en-US, used to recognize speech
+func (g *Gather) SetLanguage(language string) *Gather {
+	g.Language = language
+
+	return g
+}
+
+// SetHints sets the hints attribute, a comma separated list of words and phrases likely to be spoken
+func (g *Gather) SetHints(hints string) *Gather {
+	g.Hints = hints
+
+	return g
+}
+
+// SetSpeechModel sets the speechModel attribute. Not every model supports every language.
+func (g *Gather) SetSpeechModel(speechModel SpeechModelType) *Gather {
+	g.SpeechModel = speechModel
+
+	return g
+}
+
+// SetProfanityFilter sets the profanityFilter attribute
+func (g *Gather) SetProfanityFilter(profanityFilter bool) *Gather {
+	g.ProfanityFilter = &profanityFilter
+
+	return g
+}
+
+// SetSpeechTimeout sets the speechTimeout attribute, the seconds of silence after which speech
+// is submitted
+func (g *Gather) SetSpeechTimeout(speechTimeout uint) *Gather {
+	g.SpeechTimeout = strconv.FormatUint(uint64(speechTimeout), 10)
+
+	return g
+}
+
+// SetSpeechTimeoutAuto sets the speechTimeout attribute to auto, so that speech is submitted
+// when Twilio detects the caller has paused
+func (g *Gather) SetSpeechTimeoutAuto() *Gather {
+	g.SpeechTimeout = "auto"
+
+	return g
+}
+
+// Warnings returns problems with the Gather which are valid TwiML, but are likely a mistake
+func (g *Gather) Warnings() []string {
+	var warnings []string
+	if !g.hasInput(DTMFInput) && (g.NumDigits != 0 || g.FinishOnKey != nil) {
+		warnings = append(warnings, fmt.Sprintf("twiml.Gather.Warnings(): numDigits and finishOnKey only apply to dtmf input, so they are ignored, input=%q", g.Input))
+	}
+
+	return warnings
+}
+
+// Validate checks that the Gather either prompts the caller or submits their input
+// to an action, since otherwise the caller hears silence and their input goes nowhere.
+// It also checks that attributes which only apply to speech input are not set without it,
+// that DTMF input can complete when it is combined with speech input, that the
+// speechModel supports the language, that each method is GET or POST, and that the timeout
+// is at least a second and within the four hours a call may last.
+func (g *Gather) Validate() error {
+	var errs []error
+
+	if err := validateSeconds("timeout", g.Timeout, g.subSecondTimeout, 1, maxCallSeconds); err != nil {
+		errs = append(errs, fmt.Errorf("twiml.Gather.Validate(): %w", err))
+	}
+
+	if len(g.Verbs) == 0 && g.Action == "" {
+		errs = append(errs, fmt.Errorf("twiml.Gather.Validate(): Gather has no nested verbs to prompt the caller and no action to submit input to"))
+	}
+
+	if len(g.Verbs) == 1 {
+		if _, ok := g.Verbs[0].(*Pause); ok {
+			errs = append(errs, fmt.Errorf("twiml.Gather.Validate(): Gather only contains a Pause, the caller is never prompted"))
+		}
+	}
+
+	for _, m := range strings.Fields(g.Input) {
+		if mode := InputType(m); mode != DTMFInput && mode != SpeechInput {
+			errs = append(errs, fmt.Errorf("twiml.Gather.Validate(): unknown input mode %q, input=%q", m, g.Input))
+		}
+	}
+
+	if g.hasInput(DTMFInput) && g.hasInput(SpeechInput) && g.NumDigits == 0 && g.FinishOnKey != nil && *g.FinishOnKey == "" {
+		errs = append(errs, fmt.Errorf("twiml.Gather.Validate(): finishOnKey is disabled and numDigits is unset, so DTMF input can not complete while speech is also expected, input=%q", g.Input))
+	}
+
+	if err := validateSpeechModel(g.SpeechModel, g.Language); err != nil {
+		errs = append(errs, fmt.Errorf("twiml.Gather.Validate(): %w", err))
+	}
+
+	errs = append(errs, validateMethods(g)...)
+
+	if g.SpeechTimeout != "" && g.SpeechTimeout != "auto" {
+		if n, err := strconv.ParseUint(g.SpeechTimeout, 10, 0); err != nil || n == 0 {
+			errs = append(errs, fmt.Errorf("twiml.Gather.Validate(): speechTimeout %q must be auto or a positive number of seconds", g.SpeechTimeout))
+		}
+	}
+
+	if g.PartialResultCallback != "" {
+		if !g.hasInput(SpeechInput) {
+			errs = append(errs, fmt.Errorf("twiml.Gather.Validate(): partialResultCallback is only used with speech input, input=%q", g.Input))
+		}
+		if err := validateURL(g.PartialResultCallback); err != nil {
+			errs = append(errs, fmt.Errorf("twiml.Gather.Validate(): partialResultCallback %q: %w", g.PartialResultCallback, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// hasInput reports whether mode is one of the Gather input modes, where an unset
+// input is Twilio's default of dtmf
+func (g *Gather) hasInput(mode InputType) bool {
+	if g.Input == "" {
+		return mode == DTMFInput
+	}
+
+	for _, m := range strings.Fields(g.Input) {
+		if InputType(m) == mode {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Pause represents the TwiML Pause verb
 type Pause struct {
-	XMLName xml.Name `xml:"Pause"`
-	Length  uint     `xml:"length,attr,omitempty"`
+	XMLName xml.Name   `xml:"Pause"`
+	Length  uint       `xml:"length,attr,omitempty"`
+	Extra   []xml.Attr `xml:",any,attr"`
+
+	// subSecondLength holds a length under a second given to SetLengthDuration, which
+	// Validate reports rather than the second it was rounded up to
+	subSecondLength time.Duration
 }
 
 // NewPause returns a Pause verb
@@ -311,10 +1447,46 @@ func NewPause(length uint) *Pause {
 	return &Pause{Length: length}
 }
 
+// AddAttr adds an unmodeled attribute to the Pause
+func (p *Pause) AddAttr(name, value string) *Pause {
+	p.Extra = addAttr(p.Extra, p, name, value)
+
+	return p
+}
+
+// SetLength sets the length attribute
+func (p *Pause) SetLength(length uint) *Pause {
+	p.Length = length
+	p.subSecondLength = 0
+
+	return p
+}
+
+// SetLengthDuration sets the length attribute from a duration, rounded to the nearest
+// whole second. A duration under a second is rounded up to one second, and Validate reports
+// the duration given.
+func (p *Pause) SetLengthDuration(length time.Duration) *Pause {
+	p.Length = durationToSeconds(length)
+	p.subSecondLength = subSecond(length)
+
+	return p
+}
+
+// Validate checks that the length is at least a second and within the four hours a call
+// may last
+func (p *Pause) Validate() error {
+	if err := validateSeconds("length", p.Length, p.subSecondLength, 1, maxCallSeconds); err != nil {
+		return fmt.Errorf("twiml.Pause.Validate(): %w", err)
+	}
+
+	return nil
+}
+
 // Redirect represents the TwiML Redirect verb
 type Redirect struct {
 	XMLName xml.Name   `xml:"Redirect"`
 	Method  MethodType `xml:"method,attr,omitempty"`
+	Extra   []xml.Attr `xml:",any,attr"`
 	Value   string     `xml:",chardata"`
 }
 
@@ -323,358 +1495,1345 @@ func NewRedirect(redirect string) *Redirect {
 	return &Redirect{Value: redirect}
 }
 
-// SetMethod sets the method attribute
-func (r *Redirect) SetMethod(method MethodType) *Redirect {
-	r.Method = method
+// AddAttr adds an unmodeled attribute to the Redirect
+func (r *Redirect) AddAttr(name, value string) *Redirect {
+	r.Extra = addAttr(r.Extra, r, name, value)
+
+	return r
+}
+
+// SetMethod sets the method attribute
+func (r *Redirect) SetMethod(method MethodType) *Redirect {
+	r.Method = method
+
+	return r
+}
+
+// Validate checks that the Redirect has a valid URL
+func (r *Redirect) Validate() error {
+	if err := validateURL(r.Value); err != nil {
+		return fmt.Errorf("twiml.Redirect.Validate(): %q: %w", r.Value, err)
+	}
+
+	return nil
+}
+
+// Record represents the TwiML Record verb
+type Record struct {
+	XMLName                       xml.Name   `xml:"Record"`
+	Action                        string     `xml:"action,attr,omitempty"`
+	Method                        MethodType `xml:"method,attr,omitempty"`
+	Timeout                       uint       `xml:"timeout,attr,omitempty"`
+	FinishOnKey                   *string    `xml:"finishOnKey,attr"`
+	MaxLength                     uint       `xml:"maxLength,attr,omitempty"`
+	PlayBeep                      *bool      `xml:"playBeep,attr"`
+	Trim                          TrimType   `xml:"trim,attr,omitempty"`
+	RecordingStatusCallback       string     `xml:"recordingStatusCallback,attr,omitempty"`
+	RecordingStatusCallbackMethod MethodType `xml:"recordingStatusCallbackMethod,attr,omitempty"`
+	Transcribe                    bool       `xml:"transcribe,attr,omitempty"`
+	TranscribeCallback            string     `xml:"transcribeCallback,attr,omitempty"`
+	Extra                         []xml.Attr `xml:",any,attr"`
+
+	// subSecondTimeout and subSecondMaxLength hold durations under a second given to
+	// SetTimeoutDuration and SetMaxLengthDuration, which Validate reports rather than the
+	// second they were rounded up to
+	subSecondTimeout   time.Duration
+	subSecondMaxLength time.Duration
+}
+
+// NewRecord returns a Record verb
+func NewRecord() *Record {
+	return &Record{}
+}
+
+// AddAttr adds an unmodeled attribute to the Record
+func (r *Record) AddAttr(name, value string) *Record {
+	r.Extra = addAttr(r.Extra, r, name, value)
+
+	return r
+}
+
+// SetAction sets the action attribute
+func (r *Record) SetAction(action string) *Record {
+	r.Action = action
+
+	return r
+}
+
+// SetMethod sets the method attribute
+func (r *Record) SetMethod(method MethodType) *Record {
+	r.Method = method
+
+	return r
+}
+
+// SetTimeout sets the timeout attribute
+func (r *Record) SetTimeout(timeout uint) *Record {
+	r.Timeout = timeout
+	r.subSecondTimeout = 0
+
+	return r
+}
+
+// SetTimeoutDuration sets the timeout attribute from a duration, rounded to the nearest
+// whole second. A duration under a second is rounded up to one second, and Validate reports
+// the duration given.
+func (r *Record) SetTimeoutDuration(timeout time.Duration) *Record {
+	r.Timeout = durationToSeconds(timeout)
+	r.subSecondTimeout = subSecond(timeout)
+
+	return r
+}
+
+// SetFinishOnKey sets the finishOnKey attribute
+func (r *Record) SetFinishOnKey(finishOnKey string) *Record {
+	r.FinishOnKey = &finishOnKey
+
+	return r
+}
+
+// SetMaxLength sets the maxLength attribute
+func (r *Record) SetMaxLength(maxLength uint) *Record {
+	r.MaxLength = maxLength
+	r.subSecondMaxLength = 0
+
+	return r
+}
+
+// SetMaxLengthDuration sets the maxLength attribute from a duration, rounded to the nearest
+// whole second. A duration under a second is rounded up to one second, and Validate reports
+// the duration given.
+func (r *Record) SetMaxLengthDuration(maxLength time.Duration) *Record {
+	r.MaxLength = durationToSeconds(maxLength)
+	r.subSecondMaxLength = subSecond(maxLength)
+
+	return r
+}
+
+// SetPlayBeep sets the playBeep attribute
+func (r *Record) SetPlayBeep(playBeep bool) *Record {
+	r.PlayBeep = &playBeep
+
+	return r
+}
+
+// SetTrim sets the trim attribute
+func (r *Record) SetTrim(trim TrimType) *Record {
+	r.Trim = trim
+
+	return r
+}
+
+// SetRecordingStatusCallback sets the recordingStatusCallback attribute
+func (r *Record) SetRecordingStatusCallback(recordingStatusCallback string) *Record {
+	r.RecordingStatusCallback = recordingStatusCallback
+
+	return r
+}
+
+// SetRecordingStatusCallbackMethod sets the recordingStatusCallbackMethod attribute
+func (r *Record) SetRecordingStatusCallbackMethod(recordingStatusCallbackMethod MethodType) *Record {
+	r.RecordingStatusCallbackMethod = recordingStatusCallbackMethod
+
+	return r
+}
+
+// SetTranscribe sets the transcribe attribute. Unlike the other boolean attributes, which
+// render false once set, transcribe is only rendered when true, as false is always Twilio's
+// default.
+func (r *Record) SetTranscribe(transcribe bool) *Record {
+	r.Transcribe = transcribe
+
+	return r
+}
+
+// SetTranscribeCallback sets the transcribeCallback attribute
+func (r *Record) SetTranscribeCallback(transcribeCallback string) *Record {
+	r.TranscribeCallback = transcribeCallback
+
+	return r
+}
+
+// Validate checks that the timeout and maxLength are at least a second and within the four
+// hours Twilio allows, and that trim is a TrimType
+func (r *Record) Validate() error {
+	var errs []error
+
+	if err := validateSeconds("timeout", r.Timeout, r.subSecondTimeout, 1, maxCallSeconds); err != nil {
+		errs = append(errs, fmt.Errorf("twiml.Record.Validate(): %w", err))
+	}
+	if err := validateSeconds("maxLength", r.MaxLength, r.subSecondMaxLength, 1, maxCallSeconds); err != nil {
+		errs = append(errs, fmt.Errorf("twiml.Record.Validate(): %w", err))
+	}
+
+	if err := validateTrim(r.Trim); err != nil {
+		errs = append(errs, fmt.Errorf("twiml.Record.Validate(): %w", err))
+	}
+
+	return errors.Join(errs...)
+}
+
+// Enqueue represents the TwiML Enqueue verb, which places the caller in a queue
+type Enqueue struct {
+	XMLName       xml.Name   `xml:"Enqueue"`
+	Action        string     `xml:"action,attr,omitempty"`
+	Method        MethodType `xml:"method,attr,omitempty"`
+	WaitURL       string     `xml:"waitUrl,attr,omitempty"`
+	WaitURLMethod MethodType `xml:"waitUrlMethod,attr,omitempty"`
+	WorkflowSid   string     `xml:"workflowSid,attr,omitempty"`
+	Extra         []xml.Attr `xml:",any,attr"`
+	Value         string     `xml:",chardata"`
+}
+
+// NewEnqueue returns an Enqueue verb for the named queue
+func NewEnqueue(queue string) *Enqueue {
+	return &Enqueue{Value: queue}
+}
+
+// AddAttr adds an unmodeled attribute to the Enqueue
+func (e *Enqueue) AddAttr(name, value string) *Enqueue {
+	e.Extra = addAttr(e.Extra, e, name, value)
+
+	return e
+}
+
+// SetAction sets the action attribute
+func (e *Enqueue) SetAction(action string) *Enqueue {
+	e.Action = action
+
+	return e
+}
+
+// SetMethod sets the method attribute
+func (e *Enqueue) SetMethod(method MethodType) *Enqueue {
+	e.Method = method
+
+	return e
+}
+
+// SetWaitURL sets the waitUrl attribute, the TwiML played to the caller while they wait in
+// the queue (see EnqueueWaitLoop)
+func (e *Enqueue) SetWaitURL(waitURL string) *Enqueue {
+	e.WaitURL = waitURL
+
+	return e
+}
+
+// SetWaitURLMethod sets the waitUrlMethod attribute
+func (e *Enqueue) SetWaitURLMethod(waitURLMethod MethodType) *Enqueue {
+	e.WaitURLMethod = waitURLMethod
+
+	return e
+}
+
+// SetWorkflowSid sets the workflowSid attribute, to enqueue the call as a TaskRouter task
+func (e *Enqueue) SetWorkflowSid(workflowSid string) *Enqueue {
+	e.WorkflowSid = workflowSid
+
+	return e
+}
+
+// RejectReason is an enum for the Reject reason attribute
+type RejectReason string
+
+const (
+	// RejectedReason plays a not in service message to the caller
+	RejectedReason RejectReason = "rejected"
+
+	// BusyReason plays a busy signal to the caller
+	BusyReason RejectReason = "busy"
+)
+
+// Reject represents the TwiML Reject verb, which declines an incoming call without answering
+// it, so the call is not billed
+type Reject struct {
+	XMLName xml.Name     `xml:"Reject"`
+	Reason  RejectReason `xml:"reason,attr,omitempty"`
+	Extra   []xml.Attr   `xml:",any,attr"`
+}
+
+// NewReject returns a Reject verb
+func NewReject() *Reject {
+	return &Reject{}
+}
+
+// AddAttr adds an unmodeled attribute to the Reject
+func (r *Reject) AddAttr(name, value string) *Reject {
+	r.Extra = addAttr(r.Extra, r, name, value)
+
+	return r
+}
+
+// SetReason sets the reason attribute
+func (r *Reject) SetReason(reason RejectReason) *Reject {
+	r.Reason = reason
+
+	return r
+}
+
+// Validate checks that the reason is rejected or busy, as Twilio does not accept any other
+func (r *Reject) Validate() error {
+	if r.Reason != "" && r.Reason != RejectedReason && r.Reason != BusyReason {
+		return fmt.Errorf("twiml.Reject.Validate(): unknown reason %q", r.Reason)
+	}
+
+	return nil
+}
+
+// BeepType is an enum type for Beep
+type BeepType string
+
+const (
+	// BeepsOn beeps on enter and exit
+	BeepsOn BeepType = "true"
+
+	// BeepsOff disables beeps
+	BeepsOff BeepType = "false"
+
+	// BeepOnEnter beeps on enter only
+	BeepOnEnter BeepType = "onEnter"
+
+	// BeepOnExit beeps on exit only
+	BeepOnExit BeepType = "onExit"
+)
+
+// TrimType is an enum for the trim attribute of recordings
+type TrimType string
+
+const (
+	// TrimSilence trims the silence from the beginning and end of the recording
+	TrimSilence TrimType = "trim-silence"
+
+	// DoNotTrim keeps the silence at the beginning and end of the recording
+	DoNotTrim TrimType = "do-not-trim"
+)
+
+// validateTrim checks that trim is unset or one of the TrimType values
+func validateTrim(trim TrimType) error {
+	switch trim {
+	case "", TrimSilence, DoNotTrim:
+		return nil
+	}
+
+	return fmt.Errorf("trim %q must be %s or %s", trim, TrimSilence, DoNotTrim)
+}
+
+// RegionType is an enum for the Twilio media regions a Conference can be mixed in
+type RegionType string
+
+const (
+	// RegionUS1 is the United States region
+	RegionUS1 RegionType = "us1"
+
+	// RegionIE1 is the Ireland region
+	RegionIE1 RegionType = "ie1"
+
+	// RegionDE1 is the Germany region
+	RegionDE1 RegionType = "de1"
+
+	// RegionSG1 is the Singapore region
+	RegionSG1 RegionType = "sg1"
+
+	// RegionAU1 is the Australia region
+	RegionAU1 RegionType = "au1"
+
+	// RegionJP1 is the Japan region
+	RegionJP1 RegionType = "jp1"
+
+	// RegionBR1 is the Brazil region
+	RegionBR1 RegionType = "br1"
+)
+
+// Conference represents the twiml Conference verb
+//
+// Announcements to a conference in progress, such as an agent joining, are not TwiML
+// attributes. They are made by updating the Conference or Participant through the REST API
+// with its AnnounceUrl and AnnounceMethod, where the AnnounceUrl returns TwiML containing
+// Play or Say.
+type Conference struct {
+	XMLName                       xml.Name   `xml:"Conference"`
+	Muted                         *bool      `xml:"muted,attr"`
+	Beep                          BeepType   `xml:"beep,attr,omitempty"`
+	StartConferenceOnEnter        *bool      `xml:"startConferenceOnEnter,attr"`
+	EndConferenceOnExit           *bool      `xml:"endConferenceOnExit,attr"`
+	WaitURL                       *string    `xml:"waitUrl,attr"`
+	WaitMethod                    MethodType `xml:"waitMethod,attr,omitempty"`
+	MaxParticipants               int        `xml:"maxParticipants,attr,omitempty"`
+	Record                        string     `xml:"record,attr,omitempty"`
+	Region                        RegionType `xml:"region,attr,omitempty"`
+	Trim                          TrimType   `xml:"trim,attr,omitempty"`
+	Coach                         string     `xml:"coach,attr,omitempty"`
+	StatusCallbackEvent           string     `xml:"statusCallbackEvent,attr,omitempty"`
+	StatusCallback                string     `xml:"statusCallback,attr,omitempty"`
+	StatusCallbackMethod          MethodType `xml:"statusCallbackMethod,attr,omitempty"`
+	RecordingStatusCallback       string     `xml:"recordingStatusCallback,attr,omitempty"`
+	RecordingStatusCallbackMethod MethodType `xml:"recordingStatusCallbackMethod,attr,omitempty"`
+	RecordingStatusCallbackEvent  string     `xml:"recordingStatusCallbackEvent,attr,omitempty"`
+	EventCallbackURL              string     `xml:"eventCallbackUrl,attr,omitempty"`
+	Extra                         []xml.Attr `xml:",any,attr"`
+	Value                         string     `xml:",chardata"`
+}
+
+// NewConference returns a Conference verb
+func NewConference(conferenceName string) *Conference {
+	return &Conference{Value: conferenceName}
+}
+
+// AddAttr adds an unmodeled attribute to the Conference
+func (c *Conference) AddAttr(name, value string) *Conference {
+	c.Extra = addAttr(c.Extra, c, name, value)
+
+	return c
+}
+
+// SetMuted sets the muted attribute. False is rendered too, rather than left to Twilio's
+// default.
+func (c *Conference) SetMuted(muted bool) *Conference {
+	c.Muted = &muted
+
+	return c
+}
+
+// SetBeep sets the beep attribute to one of the BeepType values
+func (c *Conference) SetBeep(beep BeepType) *Conference {
+	c.Beep = beep
+
+	return c
+}
+
+// SetStartConferenceOnEnter sets the startConferenceOnEnter attribute
+func (c *Conference) SetStartConferenceOnEnter(startConferenceOnEnter bool) *Conference {
+	c.StartConferenceOnEnter = &startConferenceOnEnter
+
+	return c
+}
+
+// SetEndConferenceOnExit sets the endConferenceOnExit attribute. False is rendered too, so a
+// participant can be explicitly kept from ending the conference.
+func (c *Conference) SetEndConferenceOnExit(endConferenceOnExit bool) *Conference {
+	c.EndConferenceOnExit = &endConferenceOnExit
+
+	return c
+}
+
+// SetWaitURL sets the waitURL attribute. The waitUrl has three states: when never set
+// Twilio plays its default hold music, when set to a URL the TwiML it returns is used,
+// and when set to an empty string nothing is played (see DisableWaitURL).
+func (c *Conference) SetWaitURL(waitURL string) *Conference {
+	c.WaitURL = &waitURL
+
+	return c
+}
+
+// DisableWaitURL sets the waitURL attribute to an empty string so participants wait in
+// silence, instead of hearing Twilio's default hold music
+func (c *Conference) DisableWaitURL() *Conference {
+	return c.SetWaitURL("")
+}
+
+// SetWaitMethod sets the waitMethod attribute
+func (c *Conference) SetWaitMethod(waitMethod MethodType) *Conference {
+	c.WaitMethod = waitMethod
+
+	return c
+}
+
+// SetMaxParticipants sets the maxParticipants attribute, which Twilio allows to be from 2 to
+// 250. Zero leaves the attribute unset, so Twilio's default of 250 is used.
+func (c *Conference) SetMaxParticipants(maxParticipants int) *Conference {
+	c.MaxParticipants = maxParticipants
+
+	return c
+}
+
+// SetRecord sets the record attribute
+func (c *Conference) SetRecord(record string) *Conference {
+	c.Record = record
+
+	return c
+}
+
+// SetRegion sets the region attribute
+func (c *Conference) SetRegion(region RegionType) *Conference {
+	c.Region = region
+
+	return c
+}
+
+// SetTrim sets the trim attribute
+func (c *Conference) SetTrim(trim TrimType) *Conference {
+	c.Trim = trim
+
+	return c
+}
+
+// SetCoach sets the coach attribute
+func (c *Conference) SetCoach(coach string) *Conference {
+	c.Coach = coach
+
+	return c
+}
+
+// Validate checks that the coach is a CallSid, since coaching is silently disabled if
+// Twilio can not find the participant to coach. It also checks that maxParticipants, when
+// set, is within the 2 to 250 Twilio allows, and that beep is a known BeepType.
+func (c *Conference) Validate() error {
+	var errs []error
+
+	if c.Coach != "" && !strings.HasPrefix(c.Coach, "CA") {
+		errs = append(errs, fmt.Errorf("twiml.Conference.Validate(): coach %q must be the CallSid of the participant to coach, beginning with CA", c.Coach))
+	}
+
+	if c.MaxParticipants != 0 && (c.MaxParticipants < 2 || c.MaxParticipants > 250) {
+		errs = append(errs, fmt.Errorf("twiml.Conference.Validate(): maxParticipants %d must be between 2 and 250", c.MaxParticipants))
+	}
+
+	switch c.Region {
+	case "", RegionUS1, RegionIE1, RegionDE1, RegionSG1, RegionAU1, RegionJP1, RegionBR1:
+	default:
+		errs = append(errs, fmt.Errorf("twiml.Conference.Validate(): unknown region %q", c.Region))
+	}
+
+	switch c.Beep {
+	case "", BeepsOn, BeepsOff, BeepOnEnter, BeepOnExit:
+	default:
+		errs = append(errs, fmt.Errorf("twiml.Conference.Validate(): unknown beep %q", c.Beep))
+	}
+
+	if err := validateTrim(c.Trim); err != nil {
+		errs = append(errs, fmt.Errorf("twiml.Conference.Validate(): %w", err))
+	}
+
+	return errors.Join(errs...)
+}
+
+// Warnings returns problems with the Conference which are valid TwiML, but are likely a mistake
+func (c *Conference) Warnings() []string {
+	var warnings []string
+	if c.Coach != "" && c.Muted != nil && *c.Muted {
+		warnings = append(warnings, fmt.Sprintf("twiml.Conference.Warnings(): coach %q is set, but muted, so the participant being coached can not hear the coach", c.Coach))
+	}
+
+	return warnings
+}
+
+// SetStatusCallbackEvent sets the statusCallbackEvent attribute
+func (c *Conference) SetStatusCallbackEvent(statusCallbackEvent ConferenceCallbackEvent) *Conference {
+	c.StatusCallbackEvent = string(statusCallbackEvent)
+
+	return c
+}
+
+// SetStatusCallback sets the statusCallback attribute
+func (c *Conference) SetStatusCallback(statusCallback string) *Conference {
+	c.StatusCallback = statusCallback
+
+	return c
+}
+
+// SetStatusCallbackMethod sets the statusCallbackMethod attribute
+func (c *Conference) SetStatusCallbackMethod(statusCallbackMethod MethodType) *Conference {
+	c.StatusCallbackMethod = statusCallbackMethod
+
+	return c
+}
+
+// SetRecordingStatusCallback sets the recordingStatusCallback attribute
+func (c *Conference) SetRecordingStatusCallback(recordingStatusCallback string) *Conference {
+	c.RecordingStatusCallback = recordingStatusCallback
+
+	return c
+}
+
+// SetRecordingStatusCallbackMethod sets the recordingStatusCallbackMethod attribute
+func (c *Conference) SetRecordingStatusCallbackMethod(recordingStatusCallbackMethod MethodType) *Conference {
+	c.RecordingStatusCallbackMethod = recordingStatusCallbackMethod
+
+	return c
+}
+
+// SetRecordingStatusCallbackEvent sets the recordingStatusCallbackEvent attribute
+func (c *Conference) SetRecordingStatusCallbackEvent(recordingStatusCallbackEvent string) *Conference {
+	c.RecordingStatusCallbackEvent = recordingStatusCallbackEvent
+
+	return c
+}
+
+// SetEventCallbackURL sets the eventCallbackURL attribute
+func (c *Conference) SetEventCallbackURL(eventCallbackURL string) *Conference {
+	c.EventCallbackURL = eventCallbackURL
+
+	return c
+}
+
+// start end join leave mute hold speaker
+type ConferenceCallbackEvent string
+
+// ConferenceCallbackEvents enables specific Callback Events
+func ConferenceCallbackEvents() ConferenceCallbackEvent {
+	return ConferenceCallbackEvent("")
+}
+
+// Start enables the Callback Event to indicate Conference has Started
+func (c ConferenceCallbackEvent) Start() ConferenceCallbackEvent {
+	return ConferenceCallbackEvent(strings.TrimLeft(fmt.Sprintf("%s start", c), " "))
+}
+
+// End enables the Callback Event to indicate Conference has Ended
+func (c ConferenceCallbackEvent) End() ConferenceCallbackEvent {
+	return ConferenceCallbackEvent(strings.TrimLeft(fmt.Sprintf("%s end", c), " "))
+}
+
+// Join enables the Callback Event to indicate Participant has joined
+func (c ConferenceCallbackEvent) Join() ConferenceCallbackEvent {
+	return ConferenceCallbackEvent(strings.TrimLeft(fmt.Sprintf("%s join", c), " "))
+}
+
+// Leave enables the Callback Event to indicate Participant has left
+func (c ConferenceCallbackEvent) Leave() ConferenceCallbackEvent {
+	return ConferenceCallbackEvent(strings.TrimLeft(fmt.Sprintf("%s leave", c), " "))
+}
+
+// Mute enables the Callback Event to indicate Participant has been muted/unmuted
+func (c ConferenceCallbackEvent) Mute() ConferenceCallbackEvent {
+	return ConferenceCallbackEvent(strings.TrimLeft(fmt.Sprintf("%s mute", c), " "))
+}
+
+// Hold enables the Callback Event to indicate Participant has been held
+func (c ConferenceCallbackEvent) Hold() ConferenceCallbackEvent {
+	return ConferenceCallbackEvent(strings.TrimLeft(fmt.Sprintf("%s hold", c), " "))
+}
+
+// Speaker enables the Callback Event to indicate Participant has started/stoped speaking
+func (c ConferenceCallbackEvent) Speaker() ConferenceCallbackEvent {
+	return ConferenceCallbackEvent(strings.TrimLeft(fmt.Sprintf("%s speaker", c), " "))
+}
+
+// Play represents the TwiML Play verb
+type Play struct {
+	XMLName xml.Name   `xml:"Play"`
+	Digits  string     `xml:"digits,attr,omitempty"`
+	Loop    *uint      `xml:"loop,attr"`
+	Extra   []xml.Attr `xml:",any,attr"`
+	Value   string     `xml:",chardata"`
+}
+
+// NewPlay returns a Play verb
+func NewPlay(msg string) *Play {
+	return &Play{Value: msg}
+}
+
+// AddAttr adds an unmodeled attribute to the Play
+func (p *Play) AddAttr(name, value string) *Play {
+	p.Extra = addAttr(p.Extra, p, name, value)
+
+	return p
+}
+
+// SetDigits sets the digits value
+func (p *Play) SetDigits(digits string) *Play {
+	p.Digits = digits
+
+	return p
+}
+
+// SetLoop sets the number of times to repeat. When unset, Twilio plays it once, while a
+// loop of zero repeats it until the call ends (see SetInfiniteLoop).
+func (p *Play) SetLoop(loop uint) *Play {
+	p.Loop = &loop
+
+	return p
+}
+
+// SetInfiniteLoop repeats the Play until the call ends, by setting loop to zero
+func (p *Play) SetInfiniteLoop() *Play {
+	return p.SetLoop(0)
+}
+
+// Validate checks that the Play has a URL or digits to play, and that digits only contains
+// DTMF tones or w
+func (p *Play) Validate() error {
+	if strings.TrimSpace(p.Value) == "" && p.Digits == "" {
+		return fmt.Errorf("twiml.Play.Validate(): Play has neither a URL nor digits to play")
+	}
+
+	if err := validateDTMFDigits(p.Digits); err != nil {
+		return fmt.Errorf("twiml.Play.Validate(): invalid digits %q: %w", p.Digits, err)
+	}
+
+	return nil
+}
+
+// Warnings returns problems with the Play which are valid TwiML, but are likely a mistake
+func (p *Play) Warnings() []string {
+	var warnings []string
+	if strings.TrimSpace(p.Value) != "" && p.Digits != "" {
+		warnings = append(warnings, fmt.Sprintf("twiml.Play.Warnings(): digits %q are played, so the URL %q is ignored", p.Digits, p.Value))
+	}
+
+	return warnings
+}
+
+// Start represents the TwiML Start verb
+type Start struct {
+	XMLName xml.Name   `xml:"Start"`
+	Extra   []xml.Attr `xml:",any,attr"`
+	Verbs   []Verb
+}
+
+// NewStart returns a Start verb
+func NewStart() *Start {
+	return &Start{}
+}
+
+// AddAttr adds an unmodeled attribute to the Start
+func (s *Start) AddAttr(name, value string) *Start {
+	s.Extra = addAttr(s.Extra, s, name, value)
+
+	return s
+}
+
+// Stream adds the stream verb to the Start
+func (s *Start) Stream(stream *Stream) *Start {
+	s.Verbs = append(s.Verbs, stream)
+
+	return s
+}
+
+// Connect represents the TwiML Connect verb, used to start a bidirectional Stream or to
+// connect the call to a ConversationRelay
+type Connect struct {
+	XMLName xml.Name   `xml:"Connect"`
+	Action  string     `xml:"action,attr,omitempty"`
+	Method  MethodType `xml:"method,attr,omitempty"`
+	Extra   []xml.Attr `xml:",any,attr"`
+	Verbs   []Verb
+}
+
+// NewConnect returns a Connect verb
+func NewConnect() *Connect {
+	return &Connect{}
+}
+
+// AddAttr adds an unmodeled attribute to the Connect
+func (c *Connect) AddAttr(name, value string) *Connect {
+	c.Extra = addAttr(c.Extra, c, name, value)
+
+	return c
+}
+
+// SetAction sets the action attribute
+func (c *Connect) SetAction(action string) *Connect {
+	c.Action = action
+
+	return c
+}
+
+// SetMethod sets the method attribute
+func (c *Connect) SetMethod(method MethodType) *Connect {
+	c.Method = method
+
+	return c
+}
+
+// Stream adds the stream verb to the Connect
+func (c *Connect) Stream(stream *Stream) *Connect {
+	c.Verbs = append(c.Verbs, stream)
+
+	return c
+}
+
+// ConversationRelay adds the ConversationRelay noun to the Connect
+func (c *Connect) ConversationRelay(conversationRelay *ConversationRelay) *Connect {
+	c.Verbs = append(c.Verbs, conversationRelay)
+
+	return c
+}
+
+// Validate checks that each Stream of the Connect has a url, as Twilio has nowhere to
+// send the audio without one
+func (c *Connect) Validate() error {
+	var errs []error
+	for i, v := range c.Verbs {
+		if s, ok := v.(*Stream); ok && s.URL == "" {
+			errs = append(errs, fmt.Errorf("twiml.Connect.Validate(): Stream %d has no url", i))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Warnings returns problems with the Connect which are valid TwiML, but are likely a mistake
+func (c *Connect) Warnings() []string {
+	var warnings []string
+	for i, v := range c.Verbs {
+		if s, ok := v.(*Stream); ok && s.Track != "" && s.Track != InboundTrack {
+			warnings = append(warnings, fmt.Sprintf("twiml.Connect.Warnings(): Stream %d has track %q, but a bidirectional Stream only receives the inbound_track", i, s.Track))
+		}
+	}
+
+	return warnings
+}
+
+// Stream represents the TwiML Stream verb
+type Stream struct {
+	XMLName              xml.Name   `xml:"Stream"`
+	Track                TrackType  `xml:"track,attr,omitempty"`
+	Name                 string     `xml:"name,attr,omitempty"`
+	URL                  string     `xml:"url,attr,omitempty"`
+	StatusCallback       string     `xml:"statusCallback,attr,omitempty"`
+	StatusCallbackMethod MethodType `xml:"statusCallbackMethod,attr,omitempty"`
+	Extra                []xml.Attr `xml:",any,attr"`
+	Verbs                []Verb
+}
+
+// NewStream returns a Stream verb
+func NewStream() *Stream {
+	return &Stream{}
+}
+
+// AddAttr adds an unmodeled attribute to the Stream
+func (s *Stream) AddAttr(name, value string) *Stream {
+	s.Extra = addAttr(s.Extra, s, name, value)
+
+	return s
+}
+
+// SetTrack sets the track value
+func (s *Stream) SetTrack(track TrackType) *Stream {
+	s.Track = track
+
+	return s
+}
+
+// SetBothTracks streams both the inbound and outbound audio, for a Stream started with Start.
+// A bidirectional Stream within Connect only receives the inbound_track, and the audio sent
+// back over it is the outbound audio.
+func (s *Stream) SetBothTracks() *Stream {
+	return s.SetTrack(BothTracks)
+}
+
+// SetName sets the Name attribute
+func (s *Stream) SetName(name string) *Stream {
+	s.Name = name
+
+	return s
+}
+
+// SetURL sets the URL attribute
+func (s *Stream) SetURL(rawURL string) *Stream {
+	s.URL = rawURL
+
+	return s
+}
+
+// SetStatusCallback sets the statusCallback attribute
+func (s *Stream) SetStatusCallback(statusCallback string) *Stream {
+	s.StatusCallback = statusCallback
+
+	return s
+}
+
+// SetStatusCallbackMethod sets the statusCallbackMethod attribute
+func (s *Stream) SetStatusCallbackMethod(statusCallbackMethod MethodType) *Stream {
+	s.StatusCallbackMethod = statusCallbackMethod
+
+	return s
+}
+
+// Parameter adds the stream verb to the Start
+func (s *Stream) Parameter(stream *Parameter) *Stream {
+	s.Verbs = append(s.Verbs, stream)
+
+	return s
+}
+
+// AddCustomParameter adds a Parameter with the name and value, which is delivered to the
+// stream in the CustomParameters of its start message (see StartPayload)
+func (s *Stream) AddCustomParameter(name, value string) *Stream {
+	return s.Parameter(NewParameter().SetName(name).SetValue(value))
+}
+
+// Parameters adds a Parameter for each of params. Parameters are delivered to the stream
+// as the custom parameters of its start message (see StartPayload).
+func (s *Stream) Parameters(params ...*Parameter) *Stream {
+	for _, p := range params {
+		s.Verbs = append(s.Verbs, p)
+	}
+
+	return s
+}
+
+// Parameter represents the TwiML Parameter verb
+type Parameter struct {
+	XMLName xml.Name   `xml:"Parameter"`
+	Name    string     `xml:"name,attr,omitempty"`
+	Value   string     `xml:"value,attr,omitempty"`
+	Extra   []xml.Attr `xml:",any,attr"`
+}
+
+// NewParameter returns a Parameter verb
+func NewParameter() *Parameter {
+	return &Parameter{}
+}
+
+// AddAttr adds an unmodeled attribute to the Parameter
+func (s *Parameter) AddAttr(name, value string) *Parameter {
+	s.Extra = addAttr(s.Extra, s, name, value)
+
+	return s
+}
+
+// SetName sets the Name attribute
+func (s *Parameter) SetName(name string) *Parameter {
+	s.Name = name
+
+	return s
+}
+
+// SetValue sets the value attribute as is. It is escaped as XML when rendered, and reaches
+// the Stream or Client unchanged, so this is the setter to use for most values.
+func (s *Parameter) SetValue(value string) *Parameter {
+	s.Value = value
+
+	return s
+}
+
+// SetEncodedValue sets the value attribute to value escaped for a URL query, so spaces
+// become + and & becomes %26. Use it when the receiving side builds a URL from the value
+// without escaping it, and unescape it there with url.QueryUnescape. Use SetValue otherwise,
+// as an encoded value is not decoded by Twilio.
+func (s *Parameter) SetEncodedValue(value string) *Parameter {
+	s.Value = url.QueryEscape(value)
+
+	return s
+}
+
+// Application represents the TwiML Application noun, used to Dial a TwiML App
+type Application struct {
+	XMLName        xml.Name   `xml:"Application"`
+	CustomerID     string     `xml:"customerId,attr,omitempty"`
+	Extra          []xml.Attr `xml:",any,attr"`
+	ApplicationSid string     `xml:"ApplicationSid"`
+	Verbs          []Verb
+}
+
+// NewApplication returns an Application noun for the TwiML App with the given sid
+func NewApplication(applicationSid string) *Application {
+	return &Application{ApplicationSid: applicationSid}
+}
 
-	return r
+// AddAttr adds an unmodeled attribute to the Application
+func (a *Application) AddAttr(name, value string) *Application {
+	a.Extra = addAttr(a.Extra, a, name, value)
+
+	return a
 }
 
-// BeepType is an enum type for Beep
-type BeepType string
+// SetCustomerID sets the customerId attribute
+func (a *Application) SetCustomerID(customerID string) *Application {
+	a.CustomerID = customerID
 
-const (
-	// BeepsOn beeps on enter and exit
-	BeepsOn BeepType = "true"
+	return a
+}
 
-	// BeepsOff disables beeps
-	BeepsOff BeepType = "false"
+// Parameter adds a Parameter to pass to the Application
+func (a *Application) Parameter(parameter *Parameter) *Application {
+	a.Verbs = append(a.Verbs, parameter)
 
-	// BeepOnEnter beeps on enter only
-	BeepOnEnter BeepType = "onEnter"
+	return a
+}
 
-	// BeepOnExit beeps on exit only
-	BeepOnExit BeepType = "onExit"
-)
+// Validate checks that the ApplicationSid is a TwiML App sid
+func (a *Application) Validate() error {
+	if !strings.HasPrefix(a.ApplicationSid, "AP") {
+		return fmt.Errorf("twiml.Application.Validate(): ApplicationSid %q must begin with AP", a.ApplicationSid)
+	}
 
-// Conference represents the twiml Conference verb
-type Conference struct {
-	XMLName                       xml.Name   `xml:"Conference"`
-	Muted                         bool       `xml:"muted,attr,omitempty"`
-	Beep                          BeepType   `xml:"beep,attr,omitempty"`
-	StartConferenceOnEnter        *bool      `xml:"startConferenceOnEnter,attr"`
-	EndConferenceOnExit           bool       `xml:"endConferenceOnExit,attr,omitempty"`
-	WaitURL                       *string    `xml:"waitUrl,attr"`
-	WaitMethod                    MethodType `xml:"waitMethod,attr,omitempty"`
-	MaxParticipants               int        `xml:"maxParticipants,attr,omitempty"`
-	Record                        string     `xml:"record,attr,omitempty"`
-	Region                        string     `xml:"region,attr,omitempty"`
-	Trim                          string     `xml:"trim,attr,omitempty"`
-	Coach                         string     `xml:"coach,attr,omitempty"`
-	StatusCallbackEvent           string     `xml:"statusCallbackEvent,attr,omitempty"`
-	StatusCallback                string     `xml:"statusCallback,attr,omitempty"`
-	StatusCallbackMethod          MethodType `xml:"statusCallbackMethod,attr,omitempty"`
-	RecordingStatusCallback       string     `xml:"recordingStatusCallback,attr,omitempty"`
-	RecordingStatusCallbackMethod MethodType `xml:"recordingStatusCallbackMethod,attr,omitempty"`
-	RecordingStatusCallbackEvent  string     `xml:"recordingStatusCallbackEvent,attr,omitempty"`
-	EventCallbackURL              string     `xml:"eventCallbackUrl,attr,omitempty"`
-	Value                         string     `xml:",chardata"`
+	return nil
 }
 
-// NewConference returns a Conference verb
-func NewConference(conferenceName string) *Conference {
-	return &Conference{Value: conferenceName}
+// Client represents the TwiML Client noun. The client to dial is either given by name as
+// the text of the element, or by a nested Identity which allows Parameters to be passed.
+type Client struct {
+	XMLName xml.Name   `xml:"Client"`
+	Extra   []xml.Attr `xml:",any,attr"`
+	Value   string     `xml:",chardata"`
+	Verbs   []Verb
 }
 
-// SetMuted sets the muted attribute
-func (c *Conference) SetMuted(muted bool) *Conference {
-	c.Muted = muted
+// NewClient returns a Client noun
+func NewClient() *Client {
+	return &Client{}
+}
+
+// AddAttr adds an unmodeled attribute to the Client
+func (c *Client) AddAttr(name, value string) *Client {
+	c.Extra = addAttr(c.Extra, c, name, value)
 
 	return c
 }
 
-// SetBeep sets the beep attribute
-func (c *Conference) SetBeep(beep BeepType) *Conference {
-	c.Beep = beep
+// SetName sets the name of the client to dial as the text of the Client
+func (c *Client) SetName(name string) *Client {
+	c.Value = name
 
 	return c
 }
 
-// SetStartConferenceOnEnter sets the startConferenceOnEnter attribute
-func (c *Conference) SetStartConferenceOnEnter(startConferenceOnEnter bool) *Conference {
-	c.StartConferenceOnEnter = &startConferenceOnEnter
+// Identity adds a nested Identity for the client to dial
+func (c *Client) Identity(identity string) *Client {
+	c.Verbs = append(c.Verbs, NewIdentity(identity))
 
 	return c
 }
 
-// SetEndConferenceOnExit sets the endConferenceOnExit attribute
-func (c *Conference) SetEndConferenceOnExit(endConferenceOnExit bool) *Conference {
-	c.EndConferenceOnExit = endConferenceOnExit
+// Parameter adds a Parameter to pass to the client
+func (c *Client) Parameter(parameter *Parameter) *Client {
+	c.Verbs = append(c.Verbs, parameter)
 
 	return c
 }
 
-// SetWaitURL sets the waitURL attribute
-func (c *Conference) SetWaitURL(waitURL string) *Conference {
-	c.WaitURL = &waitURL
+// Identity represents the TwiML Identity noun nested in Client
+type Identity struct {
+	XMLName xml.Name   `xml:"Identity"`
+	Extra   []xml.Attr `xml:",any,attr"`
+	Value   string     `xml:",chardata"`
+}
 
-	return c
+// NewIdentity returns an Identity noun
+func NewIdentity(identity string) *Identity {
+	return &Identity{Value: identity}
 }
 
-// SetWaitMethod sets the waitMethod attribute
-func (c *Conference) SetWaitMethod(waitMethod MethodType) *Conference {
-	c.WaitMethod = waitMethod
+// AddAttr adds an unmodeled attribute to the Identity
+func (i *Identity) AddAttr(name, value string) *Identity {
+	i.Extra = addAttr(i.Extra, i, name, value)
 
-	return c
+	return i
 }
 
-// SetMaxParticipants sets the maxParticipants attribute
-func (c *Conference) SetMaxParticipants(maxParticipants int) *Conference {
-	c.MaxParticipants = maxParticipants
+// Sip represents the TwiML Sip noun, which dials a SIP endpoint from Dial, or names the
+// SIP URI to transfer to from Refer
+type Sip struct {
+	XMLName              xml.Name   `xml:"Sip"`
+	Username             string     `xml:"username,attr,omitempty"`
+	Password             string     `xml:"password,attr,omitempty"`
+	URL                  string     `xml:"url,attr,omitempty"`
+	Method               MethodType `xml:"method,attr,omitempty"`
+	StatusCallbackEvent  string     `xml:"statusCallbackEvent,attr,omitempty"`
+	StatusCallback       string     `xml:"statusCallback,attr,omitempty"`
+	StatusCallbackMethod MethodType `xml:"statusCallbackMethod,attr,omitempty"`
+	Extra                []xml.Attr `xml:",any,attr"`
+	Value                string     `xml:",chardata"`
+}
 
-	return c
+// NewSip returns a Sip noun for the SIP URI, such as sip:alice@example.com
+func NewSip(uri string) *Sip {
+	return &Sip{Value: uri}
 }
 
-// SetRecord sets the record attribute
-func (c *Conference) SetRecord(record string) *Conference {
-	c.Record = record
+// AddAttr adds an unmodeled attribute to the Sip
+func (s *Sip) AddAttr(name, value string) *Sip {
+	s.Extra = addAttr(s.Extra, s, name, value)
 
-	return c
+	return s
 }
 
-// SetRegion sets the region attribute
-func (c *Conference) SetRegion(region string) *Conference {
-	c.Region = region
+// SetCredentials sets the username and password attributes, used to authenticate with the
+// SIP endpoint
+func (s *Sip) SetCredentials(username, password string) *Sip {
+	s.Username = username
+	s.Password = password
 
-	return c
+	return s
 }
 
-// SetTrim sets the trim attribute
-func (c *Conference) SetTrim(trim string) *Conference {
-	c.Trim = trim
+// SetURL sets the url attribute, the TwiML run for the called party before they are connected
+func (s *Sip) SetURL(rawURL string) *Sip {
+	s.URL = rawURL
 
-	return c
+	return s
 }
 
-// SetCoach sets the coach attribute
-func (c *Conference) SetCoach(coach string) *Conference {
-	c.Coach = coach
+// SetMethod sets the method attribute
+func (s *Sip) SetMethod(method MethodType) *Sip {
+	s.Method = method
 
-	return c
+	return s
 }
 
 // SetStatusCallbackEvent sets the statusCallbackEvent attribute
-func (c *Conference) SetStatusCallbackEvent(statusCallbackEvent ConferenceCallbackEvent) *Conference {
-	c.StatusCallbackEvent = string(statusCallbackEvent)
+func (s *Sip) SetStatusCallbackEvent(statusCallbackEvent DialCallbackEvent) *Sip {
+	s.StatusCallbackEvent = string(statusCallbackEvent)
 
-	return c
+	return s
 }
 
 // SetStatusCallback sets the statusCallback attribute
-func (c *Conference) SetStatusCallback(statusCallback string) *Conference {
-	c.StatusCallback = statusCallback
+func (s *Sip) SetStatusCallback(statusCallback string) *Sip {
+	s.StatusCallback = statusCallback
 
-	return c
+	return s
 }
 
 // SetStatusCallbackMethod sets the statusCallbackMethod attribute
-func (c *Conference) SetStatusCallbackMethod(statusCallbackMethod MethodType) *Conference {
-	c.StatusCallbackMethod = statusCallbackMethod
+func (s *Sip) SetStatusCallbackMethod(statusCallbackMethod MethodType) *Sip {
+	s.StatusCallbackMethod = statusCallbackMethod
 
-	return c
+	return s
 }
 
-// SetRecordingStatusCallback sets the recordingStatusCallback attribute
-func (c *Conference) SetRecordingStatusCallback(recordingStatusCallback string) *Conference {
-	c.RecordingStatusCallback = recordingStatusCallback
+// Validate checks that the Sip is a valid SIP URI
+func (s *Sip) Validate() error {
+	if _, err := parseSIPURI(s.Value); err != nil {
+		return fmt.Errorf("twiml.Sip.Validate(): %q: %w", s.Value, err)
+	}
 
-	return c
+	return nil
 }
 
-// SetRecordingStatusCallbackMethod sets the recordingStatusCallbackMethod attribute
-func (c *Conference) SetRecordingStatusCallbackMethod(recordingStatusCallbackMethod MethodType) *Conference {
-	c.RecordingStatusCallbackMethod = recordingStatusCallbackMethod
+// Refer represents the TwiML Refer verb, which transfers a SIP call to the SIP URI of
+// its nested Sip
+type Refer struct {
+	XMLName xml.Name   `xml:"Refer"`
+	Action  string     `xml:"action,attr,omitempty"`
+	Method  MethodType `xml:"method,attr,omitempty"`
+	Extra   []xml.Attr `xml:",any,attr"`
+	Verbs   []Verb
+}
 
-	return c
+// NewRefer returns a Refer verb which transfers the call to the SIP URI
+func NewRefer(uri string) *Refer {
+	return &Refer{Verbs: []Verb{NewSip(uri)}}
 }
 
-// SetRecordingStatusCallbackEvent sets the recordingStatusCallbackEvent attribute
-func (c *Conference) SetRecordingStatusCallbackEvent(recordingStatusCallbackEvent string) *Conference {
-	c.RecordingStatusCallbackEvent = recordingStatusCallbackEvent
+// AddAttr adds an unmodeled attribute to the Refer
+func (r *Refer) AddAttr(name, value string) *Refer {
+	r.Extra = addAttr(r.Extra, r, name, value)
 
-	return c
+	return r
 }
 
-// SetEventCallbackURL sets the eventCallbackURL attribute
-func (c *Conference) SetEventCallbackURL(eventCallbackURL string) *Conference {
-	c.EventCallbackURL = eventCallbackURL
+// SetAction sets the action attribute
+func (r *Refer) SetAction(action string) *Refer {
+	r.Action = action
 
-	return c
+	return r
 }
 
-// start end join leave mute hold speaker
-type ConferenceCallbackEvent string
+// SetMethod sets the method attribute
+func (r *Refer) SetMethod(method MethodType) *Refer {
+	r.Method = method
 
-// ConferenceCallbackEvents enables specific Callback Events
-func ConferenceCallbackEvents() ConferenceCallbackEvent {
-	return ConferenceCallbackEvent("")
+	return r
 }
 
-// Start enables the Callback Event to indicate Conference has Started
-func (c ConferenceCallbackEvent) Start() ConferenceCallbackEvent {
-	return ConferenceCallbackEvent(strings.TrimLeft(fmt.Sprintf("%s start", c), " "))
-}
+// Validate checks that the Refer contains exactly one Sip to transfer the call to. The
+// SIP URI itself is checked by Sip.Validate.
+func (r *Refer) Validate() error {
+	if len(r.Verbs) != 1 {
+		return fmt.Errorf("twiml.Refer.Validate(): Refer must contain exactly one Sip, found %d", len(r.Verbs))
+	}
 
-// End enables the Callback Event to indicate Conference has Ended
-func (c ConferenceCallbackEvent) End() ConferenceCallbackEvent {
-	return ConferenceCallbackEvent(strings.TrimLeft(fmt.Sprintf("%s end", c), " "))
+	return nil
 }
 
-// Join enables the Callback Event to indicate Participant has joined
-func (c ConferenceCallbackEvent) Join() ConferenceCallbackEvent {
-	return ConferenceCallbackEvent(strings.TrimLeft(fmt.Sprintf("%s join", c), " "))
+// ConversationRelay represents the TwiML ConversationRelay noun of Connect, which connects
+// the call to a conversational AI application over a WebSocket. Twilio converts the
+// caller's speech to text and the text sent back to speech.
+type ConversationRelay struct {
+	XMLName               xml.Name   `xml:"ConversationRelay"`
+	URL                   string     `xml:"url,attr,omitempty"`
+	WelcomeGreeting       string     `xml:"welcomeGreeting,attr,omitempty"`
+	Voice                 string     `xml:"voice,attr,omitempty"`
+	Language              string     `xml:"language,attr,omitempty"`
+	TTSProvider           string     `xml:"ttsProvider,attr,omitempty"`
+	TranscriptionProvider string     `xml:"transcriptionProvider,attr,omitempty"`
+	Extra                 []xml.Attr `xml:",any,attr"`
+	Verbs                 []Verb
 }
 
-// Leave enables the Callback Event to indicate Participant has left
-func (c ConferenceCallbackEvent) Leave() ConferenceCallbackEvent {
-	return ConferenceCallbackEvent(strings.TrimLeft(fmt.Sprintf("%s leave", c), " "))
+// NewConversationRelay returns a ConversationRelay noun
+func NewConversationRelay() *ConversationRelay {
+	return &ConversationRelay{}
 }
 
-// Mute enables the Callback Event to indicate Participant has been muted/unmuted
-func (c ConferenceCallbackEvent) Mute() ConferenceCallbackEvent {
-	return ConferenceCallbackEvent(strings.TrimLeft(fmt.Sprintf("%s mute", c), " "))
-}
+// AddAttr adds an unmodeled attribute to the ConversationRelay
+func (c *ConversationRelay) AddAttr(name, value string) *ConversationRelay {
+	c.Extra = addAttr(c.Extra, c, name, value)
 
-// Hold enables the Callback Event to indicate Participant has been held
-func (c ConferenceCallbackEvent) Hold() ConferenceCallbackEvent {
-	return ConferenceCallbackEvent(strings.TrimLeft(fmt.Sprintf("%s hold", c), " "))
+	return c
 }
 
-// Speaker enables the Callback Event to indicate Participant has started/stoped speaking
-func (c ConferenceCallbackEvent) Speaker() ConferenceCallbackEvent {
-	return ConferenceCallbackEvent(strings.TrimLeft(fmt.Sprintf("%s speaker", c), " "))
-}
+// SetURL sets the url attribute, the wss URL of the WebSocket server
+func (c *ConversationRelay) SetURL(rawURL string) *ConversationRelay {
+	c.URL = rawURL
 
-// Play represents the TwiML Play verb
-type Play struct {
-	XMLName xml.Name `xml:"Play"`
-	Digits  string   `xml:"digits,attr,omitempty"`
-	Loop    uint     `xml:"loop,attr,omitempty"`
-	Value   string   `xml:",chardata"`
+	return c
 }
 
-// NewPlay returns a Play verb
-func NewPlay(msg string) *Play {
-	return &Play{Value: msg}
+// SetWelcomeGreeting sets the welcomeGreeting attribute, which is said when the call connects
+func (c *ConversationRelay) SetWelcomeGreeting(welcomeGreeting string) *ConversationRelay {
+	c.WelcomeGreeting = welcomeGreeting
+
+	return c
 }
 
-// SetDigits sets the digits value
-func (p *Play) SetDigits(digits string) *Play {
-	p.Digits = digits
+// SetVoice sets the voice attribute, a voice of the ttsProvider
+func (c *ConversationRelay) SetVoice(voice string) *ConversationRelay {
+	c.Voice = voice
 
-	return p
+	return c
 }
 
-// SetLoop sets the Loop value
-func (p *Play) SetLoop(loop uint) *Play {
-	p.Loop = loop
+// SetLanguage sets the language attribute, such as en-US, used for both speech recognition
+// and text-to-speech
+func (c *ConversationRelay) SetLanguage(language string) *ConversationRelay {
+	c.Language = language
 
-	return p
+	return c
 }
 
-// Start represents the TwiML Start verb
-type Start struct {
-	XMLName xml.Name `xml:"Start"`
-	Verbs   []interface{}
-}
+// SetTTSProvider sets the ttsProvider attribute, such as ElevenLabs or Google
+func (c *ConversationRelay) SetTTSProvider(ttsProvider string) *ConversationRelay {
+	c.TTSProvider = ttsProvider
 
-// NewStart returns a Start verb
-func NewStart() *Start {
-	return &Start{}
+	return c
 }
 
-// Stream adds the stream verb to the Start
-func (s *Start) Stream(stream *Stream) *Start {
-	s.Verbs = append(s.Verbs, stream)
+// SetTranscriptionProvider sets the transcriptionProvider attribute, such as Deepgram or Google
+func (c *ConversationRelay) SetTranscriptionProvider(transcriptionProvider string) *ConversationRelay {
+	c.TranscriptionProvider = transcriptionProvider
 
-	return s
+	return c
 }
 
-// Stream represents the TwiML Stream verb
-type Stream struct {
-	XMLName              xml.Name   `xml:"Stream"`
-	Track                TrackType  `xml:"track,attr,omitempty"`
-	Name                 string     `xml:"name,attr,omitempty"`
-	URL                  string     `xml:"url,attr,omitempty"`
-	StatusCallback       string     `xml:"statusCallback,attr,omitempty"`
-	StatusCallbackMethod MethodType `xml:"statusCallbackMethod,attr,omitempty"`
-	Verbs                []interface{}
-}
+// Languages adds a Language to the ConversationRelay for each language, configuring the
+// providers used when the conversation switches to that language. It is not named Language,
+// as that is the attribute for the initial language.
+func (c *ConversationRelay) Languages(languages ...*Language) *ConversationRelay {
+	for _, l := range languages {
+		c.Verbs = append(c.Verbs, l)
+	}
 
-// NewStream returns a Stream verb
-func NewStream() *Stream {
-	return &Stream{}
+	return c
 }
 
-// SetTrack sets the track value
-func (s *Stream) SetTrack(track TrackType) *Stream {
-	s.Track = track
+// Parameter adds a Parameter to the ConversationRelay, which is sent to the WebSocket server
+// when the session starts
+func (c *ConversationRelay) Parameter(parameter *Parameter) *ConversationRelay {
+	c.Verbs = append(c.Verbs, parameter)
 
-	return s
+	return c
 }
 
-// SetName sets the Name attribute
-func (s *Stream) SetName(name string) *Stream {
-	s.Name = name
+// Validate checks that the ConversationRelay has a wss url to connect to
+func (c *ConversationRelay) Validate() error {
+	if c.URL == "" {
+		return fmt.Errorf("twiml.ConversationRelay.Validate(): ConversationRelay has no url")
+	}
 
-	return s
+	if u, err := url.Parse(c.URL); err != nil || u.Scheme != "wss" || u.Host == "" {
+		return fmt.Errorf("twiml.ConversationRelay.Validate(): url %q must be an absolute wss URL", c.URL)
+	}
+
+	return nil
 }
 
-// SetURL sets the URL attribute
-func (s *Stream) SetURL(url string) *Stream {
-	s.URL = url
+// Language represents the TwiML Language noun of ConversationRelay
+type Language struct {
+	XMLName               xml.Name   `xml:"Language"`
+	Code                  string     `xml:"code,attr,omitempty"`
+	TTSProvider           string     `xml:"ttsProvider,attr,omitempty"`
+	Voice                 string     `xml:"voice,attr,omitempty"`
+	TranscriptionProvider string     `xml:"transcriptionProvider,attr,omitempty"`
+	SpeechModel           string     `xml:"speechModel,attr,omitempty"`
+	Extra                 []xml.Attr `xml:",any,attr"`
+}
 
-	return s
+// NewLanguage returns a Language noun for the language code, such as fr-FR
+func NewLanguage(code string) *Language {
+	return &Language{Code: code}
 }
 
-// SetStatusCallback sets the statusCallback attribute
-func (s *Stream) SetStatusCallback(statusCallback string) *Stream {
-	s.StatusCallback = statusCallback
+// AddAttr adds an unmodeled attribute to the Language
+func (l *Language) AddAttr(name, value string) *Language {
+	l.Extra = addAttr(l.Extra, l, name, value)
 
-	return s
+	return l
 }
 
-// SetStatusCallbackMethod sets the statusCallbackMethod attribute
-func (s *Stream) SetStatusCallbackMethod(statusCallbackMethod MethodType) *Stream {
-	s.StatusCallbackMethod = statusCallbackMethod
+// SetTTSProvider sets the ttsProvider attribute
+func (l *Language) SetTTSProvider(ttsProvider string) *Language {
+	l.TTSProvider = ttsProvider
 
-	return s
+	return l
 }
 
-// Parameter adds the stream verb to the Start
-func (s *Stream) Parameter(stream *Parameter) *Stream {
-	s.Verbs = append(s.Verbs, stream)
+// SetVoice sets the voice attribute
+func (l *Language) SetVoice(voice string) *Language {
+	l.Voice = voice
 
-	return s
+	return l
 }
 
-// Parameter represents the TwiML Parameter verb
-type Parameter struct {
-	XMLName xml.Name `xml:"Parameter"`
-	Name    string   `xml:"name,attr,omitempty"`
-	Value   string   `xml:"value,attr,omitempty"`
-}
+// SetTranscriptionProvider sets the transcriptionProvider attribute
+func (l *Language) SetTranscriptionProvider(transcriptionProvider string) *Language {
+	l.TranscriptionProvider = transcriptionProvider
 
-// NewParameter returns a Parameter verb
-func NewParameter() *Parameter {
-	return &Parameter{}
+	return l
 }
 
-// SetName sets the Name attribute
-func (s *Parameter) SetName(name string) *Parameter {
-	s.Name = name
+// SetSpeechModel sets the speechModel attribute, a model of the transcriptionProvider
+func (l *Language) SetSpeechModel(speechModel string) *Language {
+	l.SpeechModel = speechModel
 
-	return s
+	return l
 }
 
-// SetValue sets the value attribute
-func (s *Parameter) SetValue(value string) *Parameter {
-	s.Value = value
+// Validate checks that the Language has a code
+func (l *Language) Validate() error {
+	if l.Code == "" {
+		return fmt.Errorf("twiml.Language.Validate(): Language has no code")
+	}
 
-	return s
+	return nil
 }
 
 // Hangup represents the TwiML Hangup verb
 type Hangup struct {
-	XMLName xml.Name `xml:"Hangup"`
+	XMLName xml.Name   `xml:"Hangup"`
+	Extra   []xml.Attr `xml:",any,attr"`
+}
+
+// AddAttr adds an unmodeled attribute to the Hangup
+func (h *Hangup) AddAttr(name, value string) *Hangup {
+	h.Extra = addAttr(h.Extra, h, name, value)
+
+	return h
+}
+
+// addAttr sets an extra attribute on v, replacing the value if name was already added.
+// Extra attributes are best-effort: names modeled by a field of v are ignored so that
+// the modeled attribute is never rendered twice.
+func addAttr(attrs []xml.Attr, v interface{}, name, value string) []xml.Attr {
+	t := reflect.TypeOf(v).Elem()
+	for i := 0; i < t.NumField(); i++ {
+		opts := strings.Split(t.Field(i).Tag.Get("xml"), ",")
+		if len(opts) > 1 && opts[0] == name && opts[1] == "attr" {
+			return attrs
+		}
+	}
+
+	for i := range attrs {
+		if attrs[i].Name.Local == name {
+			attrs[i].Value = value
+
+			return attrs
+		}
+	}
+
+	return append(attrs, xml.Attr{Name: xml.Name{Local: name}, Value: value})
 }
@@ -1,10 +1,16 @@
 package twiml
 
 import (
+	"bytes"
 	"context"
 	"encoding/xml"
+	"fmt"
+	"net/http/httptest"
 	"reflect"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestResponse_Render(t *testing.T) {
@@ -15,9 +21,9 @@ func TestResponse_Render(t *testing.T) {
 	header := xml.Header[:len(xml.Header)-1]
 
 	response1 := &Response{
-		Verbs: []interface{}{
+		Verbs: []Verb{
 			&Dial{
-				Verbs: []interface{}{
+				Verbs: []Verb{
 					&Number{
 						Value: "810-730-3842",
 					},
@@ -102,3 +108,1528 @@ func TestResponse_Render(t *testing.T) {
 		})
 	}
 }
+
+func TestGather_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		gather  *Gather
+		wantErr bool
+	}{
+		{name: "Prompt without action", gather: NewGather().Say(NewSay("Enter your pin")), wantErr: false},
+		{name: "Action without prompt", gather: NewGather().SetAction("/gather"), wantErr: false},
+		{name: "Prompt and action", gather: NewGather().SetAction("/gather").Say(NewSay("Enter your pin")).Pause(1), wantErr: false},
+		{name: "Empty", gather: NewGather(), wantErr: true},
+		{name: "Only a Pause", gather: NewGather().SetAction("/gather").Pause(1), wantErr: true},
+		{name: "Partial results with speech", gather: NewGather().SetAction("/gather").SetInput("dtmf speech").SetPartialResultCallback("/partial"), wantErr: false},
+		{name: "Partial results with dtmf", gather: NewGather().SetAction("/gather").SetInput("dtmf").SetPartialResultCallback("/partial"), wantErr: true},
+		{name: "Partial results with default input", gather: NewGather().SetAction("/gather").SetPartialResultCallback("/partial"), wantErr: true},
+		{name: "Partial results malformed URL", gather: NewGather().SetAction("/gather").SetInput("speech").SetPartialResultCallback("https://example.com/%zz"), wantErr: true},
+		{name: "Input modes", gather: NewGather().SetAction("/gather").SetInputModes(SpeechInput, DTMFInput), wantErr: false},
+		{name: "Unknown input mode", gather: NewGather().SetAction("/gather").SetInputModes(DTMFInput, "voice"), wantErr: true},
+		{name: "Combined input default finishOnKey", gather: NewGather().SetAction("/gather").SetInputModes(DTMFInput, SpeechInput)},
+		{name: "Combined input with numDigits", gather: NewGather().SetAction("/gather").SetInputModes(DTMFInput, SpeechInput).SetFinishOnKey("").SetNumDigits(4)},
+		{name: "Combined input with finishOnKey", gather: NewGather().SetAction("/gather").SetInputModes(DTMFInput, SpeechInput).SetFinishOnKey("*")},
+		{name: "Combined input can not complete", gather: NewGather().SetAction("/gather").SetInputModes(DTMFInput, SpeechInput).SetFinishOnKey(""), wantErr: true},
+		{name: "DTMF input without finishOnKey", gather: NewGather().SetAction("/gather").SetFinishOnKey("")},
+		{name: "Speech model default language", gather: NewGather().SetAction("/gather").SetInput("speech").SetSpeechModel(NumbersAndCommandsSpeechModel)},
+		{name: "Speech model supports language", gather: NewGather().SetAction("/gather").SetInput("speech").SetSpeechModel(PhoneCallSpeechModel).SetLanguage("fr-CA")},
+		{name: "Speech model does not support language", gather: NewGather().SetAction("/gather").SetInput("speech").SetSpeechModel(NumbersAndCommandsSpeechModel).SetLanguage("is-IS"), wantErr: true},
+		{name: "Default speech model any language", gather: NewGather().SetAction("/gather").SetInput("speech").SetSpeechModel(DefaultSpeechModel).SetLanguage("is-IS")},
+		{name: "Unlisted speech model", gather: NewGather().SetAction("/gather").SetInput("speech").SetSpeechModel("googlev2_telephony").SetLanguage("is-IS")},
+		{name: "Speech timeout auto", gather: NewSpeechGather().SetAction("/gather")},
+		{name: "Speech timeout seconds", gather: NewSpeechGather().SetAction("/gather").SetSpeechTimeout(3)},
+		{name: "Speech timeout zero", gather: NewSpeechGather().SetAction("/gather").SetSpeechTimeout(0), wantErr: true},
+		{name: "GET method", gather: NewGather().SetAction("/gather").SetMethod(Get)},
+		{name: "Lowercase method", gather: NewGather().SetAction("/gather").SetMethod("get"), wantErr: true},
+		{name: "Invalid method", gather: NewGather().SetAction("/gather").SetMethod("PUT"), wantErr: true},
+		{name: "Lowercase partialResultCallbackMethod", gather: NewGather().SetAction("/gather").SetInput("speech").SetPartialResultCallback("https://example.com/partial").SetPartialResultCallbackMethod("post"), wantErr: true},
+		{name: "One second timeout", gather: NewGather().SetAction("/gather").SetTimeoutDuration(time.Second)},
+		{name: "Four hour timeout", gather: NewGather().SetAction("/gather").SetTimeoutDuration(4 * time.Hour)},
+		{name: "Timeout too long", gather: NewGather().SetAction("/gather").SetTimeout(14401), wantErr: true},
+		{name: "Sub-second timeout", gather: NewGather().SetAction("/gather").SetTimeoutDuration(5 * time.Millisecond), wantErr: true},
+		{name: "Sub-second timeout replaced", gather: NewGather().SetAction("/gather").SetTimeoutDuration(5 * time.Millisecond).SetTimeout(5)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if err := tt.gather.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Gather.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	err := NewResponse().Gather(NewGather().SetAction("/gather").SetTimeoutDuration(5 * time.Millisecond)).Validate()
+	if want := "timeout 5ms is under a second, and must be between 1 and 14400 seconds"; err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Response.Validate() error = %v, want %v", err, want)
+	}
+}
+
+func TestResponse_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		response *Response
+		wantErr  bool
+	}{
+		{name: "Valid", response: NewResponse().Gather(NewGather().SetAction("/gather").Say(NewSay("Enter your pin"))).Hangup(), wantErr: false},
+		{name: "Empty Gather", response: NewResponse().Say(NewSay("Hello")).Gather(NewGather()), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if err := tt.response.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Response.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestResponse_Warnings_Unreachable(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		response *Response
+		want     []string
+	}{
+		{name: "Redirect last", response: NewResponse().Say(NewSay("Hello")).Redirect(NewRedirect("https://example.com/next"))},
+		{
+			name:     "Say after Redirect",
+			response: NewResponse().Redirect(NewRedirect("https://example.com/next")).Say(NewSay("Hello")),
+			want:     []string{"twiml.Response.Warnings(): Say 1 follows Redirect 0, so it is never reached"},
+		},
+		{
+			name:     "Verbs after Hangup",
+			response: NewResponse().Say(NewSay("Goodbye")).Hangup().Say(NewSay("Hello")).Pause(1),
+			want: []string{
+				"twiml.Response.Warnings(): Say 2 follows Hangup 1, so it is never reached",
+				"twiml.Response.Warnings(): Pause 3 follows Hangup 1, so it is never reached",
+			},
+		},
+		{
+			name:     "Hangup after Reject",
+			response: NewResponse().Reject(NewReject()).Hangup(),
+			want:     []string{"twiml.Response.Warnings(): Hangup 1 follows Reject 0, so it is never reached"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tt.response.Warnings(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Response.Warnings() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResponse_ValidateStrict(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		response     *Response
+		wantWarnings int
+		wantErr      bool
+		wantStrict   bool
+	}{
+		{name: "Valid", response: NewResponse().Say(NewSay("Hello")).Hangup()},
+		{name: "Empty", response: NewResponse(), wantWarnings: 1, wantErr: false, wantStrict: true},
+		{name: "Invalid", response: NewResponse().Gather(NewGather()), wantErr: true, wantStrict: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tt.response.Warnings(); len(got) != tt.wantWarnings {
+				t.Errorf("Response.Warnings() = %v, want %d warnings", got, tt.wantWarnings)
+			}
+			if err := tt.response.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Response.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err := tt.response.ValidateStrict(); (err != nil) != tt.wantStrict {
+				t.Errorf("Response.ValidateStrict() error = %v, wantErr %v", err, tt.wantStrict)
+			}
+		})
+	}
+}
+
+func TestDial_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		dial    *Dial
+		wantErr string
+	}{
+		{name: "Empty", dial: NewDial()},
+		{name: "One noun", dial: NewDial().Conference(NewConference("room"))},
+		{name: "Several Numbers", dial: NewDial().Numbers("+18005642365", "+18005642366")},
+		{name: "Number and Client", dial: NewDial().Numbers("+18005642365").Client(NewClient().Identity("alice")), wantErr: "found Number, Client"},
+		{name: "Two Conferences", dial: NewDial().Conference(NewConference("a")).Conference(NewConference("b")), wantErr: "found Conference, Conference"},
+		{name: "Timeout", dial: NewDial().SetTimeoutDuration(30 * time.Second).Numbers("+18005642365")},
+		{name: "Timeout too short", dial: NewDial().SetTimeout(4).Numbers("+18005642365"), wantErr: "timeout 4 must be between 5 and 600 seconds"},
+		{name: "Timeout too long", dial: NewDial().SetTimeoutDuration(time.Hour).Numbers("+18005642365"), wantErr: "timeout 3600 must be between 5 and 600 seconds"},
+		{name: "Sub-second timeout", dial: NewDial().SetTimeoutDuration(500 * time.Millisecond).Numbers("+18005642365"), wantErr: "timeout 500ms is under a second, and must be between 5 and 600 seconds"},
+		{name: "One second timeout", dial: NewDial().SetTimeout(1).Numbers("+18005642365"), wantErr: "timeout 1 must be between 5 and 600 seconds"},
+		{name: "Sub-second timeout replaced", dial: NewDial().SetTimeoutDuration(500 * time.Millisecond).SetTimeout(30).Numbers("+18005642365")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			for name, err := range map[string]error{"Dial.Validate()": tt.dial.Validate(), "Response.Validate()": NewResponse().Dial(tt.dial).Validate()} {
+				if tt.wantErr == "" {
+					if err != nil {
+						t.Errorf("%s error = %v, want nil", name, err)
+					}
+
+					continue
+				}
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("%s error = %v, want %v", name, err, tt.wantErr)
+				}
+			}
+		})
+	}
+}
+
+func TestDial_SetTimeoutDuration(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	got, err := NewResponse().Dial(NewDial().SetTimeoutDuration(500 * time.Millisecond).Numbers("+18005642365")).Render(ctx)
+	if err != nil {
+		t.Fatalf("Response.Render() error = %v", err)
+	}
+	if !strings.Contains(string(got), `<Dial timeout="1">`) {
+		t.Errorf("Response.Render() = %v, want a sub-second timeout rendered as timeout=\"1\"", string(got))
+	}
+}
+
+func TestRecord_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		record  *Record
+		wantErr bool
+	}{
+		{name: "Unset", record: NewRecord(), wantErr: false},
+		{name: "Four hours", record: NewRecord().SetMaxLengthDuration(4 * time.Hour), wantErr: false},
+		{name: "Over four hours", record: NewRecord().SetMaxLengthDuration(4*time.Hour + time.Second), wantErr: true},
+		{name: "One second maxLength", record: NewRecord().SetMaxLength(1), wantErr: false},
+		{name: "Sub-second maxLength", record: NewRecord().SetMaxLengthDuration(500 * time.Millisecond), wantErr: true},
+		{name: "One second timeout", record: NewRecord().SetTimeoutDuration(time.Second), wantErr: false},
+		{name: "Four hour timeout", record: NewRecord().SetTimeoutDuration(4 * time.Hour), wantErr: false},
+		{name: "Timeout over four hours", record: NewRecord().SetTimeout(14401), wantErr: true},
+		{name: "Sub-second timeout", record: NewRecord().SetTimeoutDuration(500 * time.Millisecond), wantErr: true},
+		{name: "Sub-second timeout replaced", record: NewRecord().SetTimeoutDuration(500 * time.Millisecond).SetTimeout(1), wantErr: false},
+		{name: "Trim", record: NewRecord().SetTrim(TrimSilence), wantErr: false},
+		{name: "Unknown trim", record: NewRecord().SetTrim("silence"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if err := NewResponse().Record(tt.record).Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Response.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPause_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		pause   *Pause
+		wantErr string
+	}{
+		{name: "Unset", pause: NewPause(0)},
+		{name: "One second", pause: NewPause(0).SetLengthDuration(time.Second)},
+		{name: "Four hours", pause: NewPause(14400)},
+		{name: "Over four hours", pause: NewPause(0).SetLengthDuration(4*time.Hour + time.Second), wantErr: "length 14401 must be between 1 and 14400 seconds"},
+		{name: "Sub-second", pause: NewPause(0).SetLengthDuration(500 * time.Millisecond), wantErr: "length 500ms is under a second, and must be between 1 and 14400 seconds"},
+		{name: "Sub-second replaced", pause: NewPause(0).SetLengthDuration(500 * time.Millisecond).SetLength(1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			for name, err := range map[string]error{"Pause.Validate()": tt.pause.Validate(), "Response.Validate()": (&Response{Verbs: []Verb{tt.pause}}).Validate()} {
+				if tt.wantErr == "" {
+					if err != nil {
+						t.Errorf("%s error = %v, want nil", name, err)
+					}
+
+					continue
+				}
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("%s error = %v, want %v", name, err, tt.wantErr)
+				}
+			}
+		})
+	}
+}
+
+func TestPlay_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		play         *Play
+		wantErr      bool
+		wantWarnings int
+	}{
+		{name: "Valid digits", play: NewPlay("").SetDigits("ww1234"), wantErr: false},
+		{name: "Valid pound star", play: NewPlay("").SetDigits("w#*0"), wantErr: false},
+		{name: "Invalid digits", play: NewPlay("").SetDigits("12-34"), wantErr: true},
+		{name: "URL", play: NewPlay("https://example.com/hold.mp3"), wantErr: false},
+		{name: "Neither URL nor digits", play: NewPlay(" "), wantErr: true},
+		{name: "URL and digits", play: NewPlay("https://example.com/hold.mp3").SetDigits("1234"), wantErr: false, wantWarnings: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if err := tt.play.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Play.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err := NewResponse().Play(tt.play).Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Response.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := NewResponse().Play(tt.play).Warnings(); len(got) != tt.wantWarnings {
+				t.Errorf("Response.Warnings() = %v, want %d warnings", got, tt.wantWarnings)
+			}
+		})
+	}
+}
+
+func TestSay_SetSSML(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	header := xml.Header[:len(xml.Header)-1]
+
+	tests := []struct {
+		name    string
+		say     *Say
+		want    string
+		wantErr bool
+	}{
+		{name: "Value is escaped", say: NewSay("").SetValue(`Hello <break time="1s"/> world`), want: `<Say>Hello &lt;break time=&#34;1s&#34;/&gt; world</Say>`},
+		{name: "SSML is not escaped", say: NewSay("").SetSSML(`Hello <break time="1s"/> <emphasis>world</emphasis>`), want: `<Say>Hello <break time="1s"/> <emphasis>world</emphasis></Say>`},
+		{name: "SetValue clears SSML", say: NewSay("").SetSSML(`<break time="1s"/>`).SetValue("Hello"), want: `<Say>Hello</Say>`},
+		{name: "Unclosed element", say: NewSay("").SetSSML(`Hello <emphasis>world`), wantErr: true},
+		{name: "Closes the Say", say: NewSay("").SetSSML(`Hello</speak><Hangup/><speak>`), wantErr: true},
+		{name: "Prosody", say: NewSay("Hello").Prosody("slow", "+10%", "", "Your code is 1 2 3"), want: `<Say><prosody rate="slow" pitch="+10%">Your code is 1 2 3</prosody></Say>`},
+		{name: "Prosody text is escaped", say: NewSay("").Prosody("", "", "loud", `<Hangup/> & "more"`), want: `<Say><prosody volume="loud">&lt;Hangup/&gt; &amp; &#34;more&#34;</prosody></Say>`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NewResponse().Say(tt.say).Render(ctx)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Response.Render() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			want := header + `
+<Response>
+  ` + tt.want + `
+</Response>`
+			if string(got) != want {
+				t.Errorf("Response.Render() = %v, want %v", string(got), want)
+			}
+		})
+	}
+}
+
+func TestResponse_SayLong(t *testing.T) {
+	t.Parallel()
+
+	sentence := strings.Repeat("word ", 199) + "end. "
+	word := strings.Repeat("a", maxSayLength)
+
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{name: "Short", text: "Hello world", want: []string{"Hello world"}},
+		{name: "Empty", text: " ", want: nil},
+		{name: "At the limit", text: word, want: []string{word}},
+		{
+			name: "Just over the limit at a sentence",
+			text: strings.Repeat(sentence, 4) + "Goodbye.",
+			want: []string{strings.TrimSpace(strings.Repeat(sentence, 4)), "Goodbye."},
+		},
+		{
+			name: "Sentence end preferred over words",
+			text: sentence + strings.Repeat("word ", 790) + "last words",
+			want: []string{strings.TrimSpace(sentence), strings.TrimSpace(strings.Repeat("word ", 790)) + " last words"},
+		},
+		{
+			name: "No sentence ends",
+			text: strings.Repeat("word ", 800) + "more",
+			want: []string{strings.TrimSpace(strings.Repeat("word ", 800)), "more"},
+		},
+		{
+			name: "No split points",
+			text: word + "bcd",
+			want: []string{word, "bcd"},
+		},
+		{
+			name: "Multibyte characters",
+			text: strings.Repeat("é", maxSayLength+1),
+			want: []string{strings.Repeat("é", maxSayLength), "é"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := NewResponse().SayLong(tt.text, AliceVoice)
+			var got []string
+			for _, v := range r.Verbs {
+				say, ok := v.(*Say)
+				if !ok {
+					t.Fatalf("Response.SayLong() added %T, want *Say", v)
+				}
+				if say.Voice != AliceVoice {
+					t.Errorf("Response.SayLong() voice = %v, want %v", say.Voice, AliceVoice)
+				}
+				if n := len([]rune(say.Value)); n > maxSayLength {
+					t.Errorf("Response.SayLong() Say has %d characters, want at most %d", n, maxSayLength)
+				}
+				got = append(got, say.Value)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Response.SayLong() = %d Says %.60q, want %d Says %.60q", len(got), got, len(tt.want), tt.want)
+			}
+		})
+	}
+}
+
+func TestSay_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		say     *Say
+		wantErr bool
+	}{
+		{name: "Text", say: NewSay("Hello")},
+		{name: "SSML", say: NewSay("").SetSSML(`<break time="1s"/>`)},
+		{name: "Empty", say: NewSay(""), wantErr: true},
+		{name: "Whitespace", say: NewSay(" \n "), wantErr: true},
+		{name: "Prosody", say: NewSay("").Prosody("x-slow", "", "", "Hello")},
+		{name: "Prosody without attributes", say: NewSay("").Prosody("", "", "", "Hello"), wantErr: true},
+		{name: "SSML prosody without attributes", say: NewSay("").SetSSML(`Hello <prosody>world</prosody>`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if err := NewResponse().Gather(NewGather().Say(tt.say)).Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Response.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestResponse_RenderLoop(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	header := xml.Header[:len(xml.Header)-1]
+
+	tests := []struct {
+		name string
+		verb Verb
+		want string
+	}{
+		{name: "Say once", verb: NewSay("Hello"), want: `<Say>Hello</Say>`},
+		{name: "Say loop", verb: NewSay("Hello").SetLoop(3), want: `<Say loop="3">Hello</Say>`},
+		{name: "Say infinite loop", verb: NewSay("Hello").SetInfiniteLoop(), want: `<Say loop="0">Hello</Say>`},
+		{name: "Play once", verb: NewPlay("https://example.com/hold.mp3"), want: `<Play>https://example.com/hold.mp3</Play>`},
+		{name: "Play loop", verb: NewPlay("https://example.com/hold.mp3").SetLoop(3), want: `<Play loop="3">https://example.com/hold.mp3</Play>`},
+		{name: "Play infinite loop", verb: NewPlay("https://example.com/hold.mp3").SetInfiniteLoop(), want: `<Play loop="0">https://example.com/hold.mp3</Play>`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := (&Response{Verbs: []Verb{tt.verb}}).Render(ctx)
+			if err != nil {
+				t.Fatalf("Response.Render() error = %v", err)
+			}
+			want := header + `
+<Response>
+  ` + tt.want + `
+</Response>`
+			if string(got) != want {
+				t.Errorf("Response.Render() = %v, want %v", string(got), want)
+			}
+		})
+	}
+}
+
+func TestConference_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		conference   *Conference
+		wantErr      bool
+		wantWarnings int
+	}{
+		{name: "No coach", conference: NewConference("room").SetMuted(true)},
+		{name: "Coach", conference: NewConference("room").SetCoach("CA5d5cfb0ff7c4b7e6b8e3dc28b4b4f3e2")},
+		{name: "Coach is not a CallSid", conference: NewConference("room").SetCoach("agent-42"), wantErr: true},
+		{name: "Muted coach", conference: NewConference("room").SetCoach("CA5d5cfb0ff7c4b7e6b8e3dc28b4b4f3e2").SetMuted(true), wantWarnings: 1},
+		{name: "Default maxParticipants", conference: NewConference("room").SetMaxParticipants(0)},
+		{name: "maxParticipants 1", conference: NewConference("room").SetMaxParticipants(1), wantErr: true},
+		{name: "maxParticipants 2", conference: NewConference("room").SetMaxParticipants(2)},
+		{name: "maxParticipants 250", conference: NewConference("room").SetMaxParticipants(250)},
+		{name: "maxParticipants 251", conference: NewConference("room").SetMaxParticipants(251), wantErr: true},
+		{name: "Negative maxParticipants", conference: NewConference("room").SetMaxParticipants(-5), wantErr: true},
+		{name: "Region", conference: NewConference("room").SetRegion(RegionIE1)},
+		{name: "Unknown region", conference: NewConference("room").SetRegion("eu1"), wantErr: true},
+		{name: "Trim", conference: NewConference("room").SetTrim(DoNotTrim)},
+		{name: "Unknown trim", conference: NewConference("room").SetTrim("trim"), wantErr: true},
+		{name: "Beep", conference: NewConference("room").SetBeep(BeepOnEnter)},
+		{name: "Unknown beep", conference: &Conference{Value: "room", Beep: "onJoin"}, wantErr: true},
+		{name: "Beep wrong case", conference: NewConference("room").SetBeep("True"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := NewResponse().Dial(NewDial().Conference(tt.conference))
+			if err := r.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Response.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := r.Warnings(); len(got) != tt.wantWarnings {
+				t.Errorf("Response.Warnings() = %v, want %d warnings", got, tt.wantWarnings)
+			}
+		})
+	}
+}
+
+func TestStream_Track(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		response     *Response
+		wantWarnings int
+	}{
+		{name: "Start default track", response: NewResponse().Start(NewStart().Stream(NewStream().SetURL("wss://example.com/audio")))},
+		{name: "Start both tracks", response: NewResponse().Start(NewStart().Stream(NewStream().SetURL("wss://example.com/audio").SetBothTracks()))},
+		{name: "Connect default track", response: NewResponse().Connect(NewConnect().Stream(NewStream().SetURL("wss://example.com/audio")))},
+		{name: "Connect inbound track", response: NewResponse().Connect(NewConnect().Stream(NewStream().SetURL("wss://example.com/audio").SetTrack(InboundTrack)))},
+		{name: "Connect both tracks", response: NewResponse().Connect(NewConnect().Stream(NewStream().SetURL("wss://example.com/audio").SetBothTracks())), wantWarnings: 1},
+		{name: "Connect outbound track", response: NewResponse().Connect(NewConnect().Stream(NewStream().SetURL("wss://example.com/audio").SetTrack(OutboundTrack))), wantWarnings: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if err := tt.response.Validate(); err != nil {
+				t.Errorf("Response.Validate() error = %v", err)
+			}
+			if got := tt.response.Warnings(); len(got) != tt.wantWarnings {
+				t.Errorf("Response.Warnings() = %v, want %d warnings", got, tt.wantWarnings)
+			}
+		})
+	}
+}
+
+func TestReject_Validate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		reject  *Reject
+		wantErr bool
+	}{
+		{name: "Default", reject: NewReject()},
+		{name: "Rejected", reject: NewReject().SetReason(RejectedReason)},
+		{name: "Busy", reject: NewReject().SetReason(BusyReason)},
+		{name: "Unknown reason", reject: NewReject().SetReason("declined"), wantErr: true},
+		{name: "Wrong case", reject: &Reject{Reason: "Busy"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if err := NewResponse().Reject(tt.reject).Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Response.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			// The reason round-trips through the rendered TwiML
+			var got struct {
+				Reject Reject
+			}
+			b, err := NewResponse().Reject(tt.reject).Render(ctx)
+			if err != nil {
+				t.Fatalf("Response.Render() error = %v", err)
+			}
+			if err := xml.Unmarshal(b, &got); err != nil {
+				t.Fatalf("xml.Unmarshal() error = %v", err)
+			}
+			if got.Reject.Reason != tt.reject.Reason {
+				t.Errorf("Reject.Reason = %q after a round trip, want %q", got.Reject.Reason, tt.reject.Reason)
+			}
+		})
+	}
+}
+
+func ExampleResponse_RejectBusy() {
+	ctx := context.Background()
+
+	// Reject a suspected fraudulent call without answering it
+	twiml, err := NewResponse().RejectBusy().Render(ctx)
+	if err != nil {
+		fmt.Println(err)
+
+		return
+	}
+	fmt.Println(string(twiml))
+
+	// The status callback for the call then reports it as busy
+	values := RequestValues{"CallSid": "CA123", "CallStatus": "busy"}
+	if values.CallStatus() == CallStatusBusy {
+		fmt.Println("rejected", values["CallSid"])
+	}
+
+	// Output:
+	// <?xml version="1.0" encoding="UTF-8"?>
+	// <Response>
+	//   <Reject reason="busy"></Reject>
+	// </Response>
+	// rejected CA123
+}
+
+func TestConference_WaitURL(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	header := xml.Header[:len(xml.Header)-1]
+
+	tests := []struct {
+		name       string
+		conference *Conference
+		want       string
+	}{
+		{name: "Default hold music", conference: NewConference("room"), want: "<Conference>room</Conference>"},
+		{name: "Silence", conference: NewConference("room").DisableWaitURL(), want: `<Conference waitUrl="">room</Conference>`},
+		{name: "Custom", conference: NewConference("room").SetWaitURL("https://example.com/wait"), want: `<Conference waitUrl="https://example.com/wait">room</Conference>`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NewResponse().Dial(NewDial().Conference(tt.conference)).Render(ctx)
+			if err != nil {
+				t.Fatalf("Response.Render() error = %v", err)
+			}
+			want := header + `
+<Response>
+  <Dial>
+    ` + tt.want + `
+  </Dial>
+</Response>`
+			if string(got) != want {
+				t.Errorf("Response.Render() = %v, want %v", string(got), want)
+			}
+		})
+	}
+}
+
+func TestGather_SetProfanityFilter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	header := xml.Header[:len(xml.Header)-1]
+
+	tests := []struct {
+		name   string
+		gather *Gather
+		want   string
+	}{
+		{name: "Unset", gather: NewGather().SetInput("speech"), want: `<Gather input="speech">`},
+		{name: "On", gather: NewGather().SetInput("speech").SetProfanityFilter(true), want: `<Gather input="speech" profanityFilter="true">`},
+		{name: "Off", gather: NewGather().SetInput("speech").SetProfanityFilter(false), want: `<Gather input="speech" profanityFilter="false">`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NewResponse().Gather(tt.gather.Say(NewSay("Speak now"))).Render(ctx)
+			if err != nil {
+				t.Fatalf("Response.Render() error = %v", err)
+			}
+			want := header + `
+<Response>
+  ` + tt.want + `
+    <Say>Speak now</Say>
+  </Gather>
+</Response>`
+			if string(got) != want {
+				t.Errorf("Response.Render() = %v, want %v", string(got), want)
+			}
+		})
+	}
+}
+
+func TestNewSpeechGather(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	header := xml.Header[:len(xml.Header)-1]
+
+	tests := []struct {
+		name         string
+		gather       *Gather
+		want         string
+		wantWarnings int
+	}{
+		{name: "Without hints", gather: NewSpeechGather(), want: `<Gather input="speech" speechTimeout="auto">`},
+		{name: "Hints", gather: NewSpeechGather("billing", "technical support"), want: `<Gather input="speech" hints="billing,technical support" speechTimeout="auto">`},
+		{name: "DTMF attributes", gather: NewSpeechGather().SetNumDigits(4), want: `<Gather input="speech" numDigits="4" speechTimeout="auto">`, wantWarnings: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := NewResponse().Gather(tt.gather.Say(NewSay("How can we help?")))
+			got, err := r.Render(ctx)
+			if err != nil {
+				t.Fatalf("Response.Render() error = %v", err)
+			}
+			want := header + `
+<Response>
+  ` + tt.want + `
+    <Say>How can we help?</Say>
+  </Gather>
+</Response>`
+			if string(got) != want {
+				t.Errorf("Response.Render() = %v, want %v", string(got), want)
+			}
+			if got := r.Warnings(); len(got) != tt.wantWarnings {
+				t.Errorf("Response.Warnings() = %v, want %d warnings", got, tt.wantWarnings)
+			}
+		})
+	}
+}
+
+func TestBoolAttributes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		attr string
+		set  func(v bool) Verb
+		// explicit is set for attributes which render false once it is set
+		explicit bool
+	}{
+		{name: "Dial answerOnBridge", attr: "answerOnBridge", set: func(v bool) Verb { return NewDial().SetAnswerOnBridge(v) }, explicit: true},
+		{name: "Gather profanityFilter", attr: "profanityFilter", set: func(v bool) Verb { return NewGather().SetProfanityFilter(v) }, explicit: true},
+		{name: "Record playBeep", attr: "playBeep", set: func(v bool) Verb { return NewRecord().SetPlayBeep(v) }, explicit: true},
+		{name: "Conference startConferenceOnEnter", attr: "startConferenceOnEnter", set: func(v bool) Verb { return NewConference("room").SetStartConferenceOnEnter(v) }, explicit: true},
+		{name: "Conference muted", attr: "muted", set: func(v bool) Verb { return NewConference("room").SetMuted(v) }, explicit: true},
+		{name: "Conference endConferenceOnExit", attr: "endConferenceOnExit", set: func(v bool) Verb { return NewConference("room").SetEndConferenceOnExit(v) }, explicit: true},
+		{name: "Record transcribe", attr: "transcribe", set: func(v bool) Verb { return NewRecord().SetTranscribe(v) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			unset := newVerb(verbName(tt.set(true)))
+			wantFalse := ""
+			if tt.explicit {
+				wantFalse = "false"
+			}
+			for _, c := range []struct {
+				verb Verb
+				want string
+			}{
+				{verb: unset, want: ""},
+				{verb: tt.set(true), want: "true"},
+				{verb: tt.set(false), want: wantFalse},
+			} {
+				b, err := xml.Marshal(c.verb)
+				if err != nil {
+					t.Fatalf("xml.Marshal() error = %v", err)
+				}
+				tok, err := xml.NewDecoder(bytes.NewReader(b)).Token()
+				if err != nil {
+					t.Fatalf("xml.Decoder.Token() error = %v", err)
+				}
+				var got string
+				for _, attr := range tok.(xml.StartElement).Attr {
+					if attr.Name.Local == tt.attr {
+						got = attr.Value
+					}
+				}
+				if got != c.want {
+					t.Errorf("%s = %q in %s, want %q", tt.attr, got, b, c.want)
+				}
+			}
+		})
+	}
+}
+
+func TestDial_SetAnswerOnBridge(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	header := xml.Header[:len(xml.Header)-1]
+
+	tests := []struct {
+		name string
+		dial *Dial
+		want string
+	}{
+		{name: "Unset", dial: NewDial(), want: `<Dial>`},
+		{name: "True", dial: NewDial().SetAnswerOnBridge(true), want: `<Dial answerOnBridge="true">`},
+		{name: "False", dial: NewDial().SetAnswerOnBridge(false), want: `<Dial answerOnBridge="false">`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NewResponse().Dial(tt.dial.Numbers("+18005642365")).Render(ctx)
+			if err != nil {
+				t.Fatalf("Response.Render() error = %v", err)
+			}
+			want := header + `
+<Response>
+  ` + tt.want + `
+    <Number>+18005642365</Number>
+  </Dial>
+</Response>`
+			if string(got) != want {
+				t.Errorf("Response.Render() = %v, want %v", string(got), want)
+			}
+		})
+	}
+}
+
+func TestDial_RecordDualChannel(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	header := xml.Header[:len(xml.Header)-1]
+
+	tests := []struct {
+		name string
+		dial *Dial
+		want string
+	}{
+		{
+			name: "Dual channel",
+			dial: NewDial().RecordDualChannel("https://example.com/recording").Number(NewNumber("810-730-3842")),
+			want: `<Dial record="record-from-answer-dual" recordingStatusCallback="https://example.com/recording" recordingStatusCallbackMethod="POST">`,
+		},
+		{
+			name: "Individual setters",
+			dial: NewDial().SetRecord(RecordFromRinging).SetRecordingStatusCallback("https://example.com/recording").Number(NewNumber("810-730-3842")),
+			want: `<Dial record="record-from-ringing" recordingStatusCallback="https://example.com/recording">`,
+		},
+		{
+			name: "Recording track",
+			dial: NewDial().SetRecord(RecordFromAnswer).SetRecordingTrack(InboundRecordingTrack).Number(NewNumber("810-730-3842")),
+			want: `<Dial record="record-from-answer" recordingTrack="inbound">`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NewResponse().Dial(tt.dial).Render(ctx)
+			if err != nil {
+				t.Fatalf("Response.Render() error = %v", err)
+			}
+			want := header + `
+<Response>
+  ` + tt.want + `
+    <Number>810-730-3842</Number>
+  </Dial>
+</Response>`
+			if string(got) != want {
+				t.Errorf("Response.Render() = %v, want %v", string(got), want)
+			}
+		})
+	}
+}
+
+func TestSip_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		response *Response
+		wantErr  bool
+	}{
+		{name: "Dial Sip", response: NewResponse().Dial(NewDial().Sip(NewSip("sip:alice@example.com")))},
+		{name: "Dial Sips", response: NewResponse().Dial(NewDial().Sip(NewSip("sips:alice@example.com:5061")))},
+		{name: "Refer", response: NewResponse().Refer(NewRefer("sip:alice@example.com").SetAction("/refer"))},
+		{name: "Missing scheme", response: NewResponse().Dial(NewDial().Sip(NewSip("alice@example.com"))), wantErr: true},
+		{name: "Wrong scheme", response: NewResponse().Dial(NewDial().Sip(NewSip("sipx:alice@example.com"))), wantErr: true},
+		{name: "Missing user", response: NewResponse().Dial(NewDial().Sip(NewSip("sip:example.com"))), wantErr: true},
+		{name: "Missing host", response: NewResponse().Refer(NewRefer("sip:alice@")), wantErr: true},
+		{name: "Path", response: NewResponse().Refer(NewRefer("sip:alice@example.com/path")), wantErr: true},
+		{name: "Refer without Sip", response: NewResponse().Refer(&Refer{}), wantErr: true},
+		{name: "Refer with two Sips", response: NewResponse().Refer(&Refer{Verbs: []Verb{NewSip("sip:alice@example.com"), NewSip("sip:bob@example.com")}}), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if err := tt.response.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Response.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestResponse_Refer(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	header := xml.Header[:len(xml.Header)-1]
+
+	got, err := NewResponse().Refer(NewRefer("sip:alice@example.com").SetAction("/refer").SetMethod(Post)).Render(ctx)
+	if err != nil {
+		t.Fatalf("Response.Render() error = %v", err)
+	}
+	want := header + `
+<Response>
+  <Refer action="/refer" method="POST">
+    <Sip>sip:alice@example.com</Sip>
+  </Refer>
+</Response>`
+	if string(got) != want {
+		t.Errorf("Response.Render() = %v, want %v", string(got), want)
+	}
+}
+
+func TestResponse_Stream(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		response *Response
+	}{
+		{name: "Empty", response: NewResponse()},
+		{name: "Dial", response: NewResponse().Dial(NewDial().Number(NewNumber("810-730-3842"))).Say(NewSay("Failed to connect"))},
+		{name: "Gather", response: NewResponse().Gather(NewGather().SetAction("/gather").Say(NewSay("Enter your pin")).Pause(1)).Redirect(NewRedirect("/start"))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			want, err := tt.response.Render(ctx)
+			if err != nil {
+				t.Fatalf("Response.Render() error = %v", err)
+			}
+			w := httptest.NewRecorder()
+			if err := tt.response.Stream(ctx, w); err != nil {
+				t.Fatalf("Response.Stream() error = %v", err)
+			}
+			if got := w.Body.String(); got != string(want) {
+				t.Errorf("Response.Stream() = %v, want %v", got, string(want))
+			}
+			if !w.Flushed {
+				t.Errorf("Response.Stream() did not flush the http.ResponseWriter")
+			}
+		})
+	}
+}
+
+func TestConnect_ConversationRelay(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	header := xml.Header[:len(xml.Header)-1]
+
+	r := NewResponse().Connect(NewConnect().SetAction("https://example.com/connected").ConversationRelay(NewConversationRelay().
+		SetURL("wss://example.com/relay").
+		SetWelcomeGreeting("Hi! How can I help?").
+		SetVoice("en-US-Journey-O").
+		SetLanguage("en-US").
+		SetTTSProvider("Google").
+		SetTranscriptionProvider("Deepgram").
+		Languages(
+			NewLanguage("fr-FR").SetTTSProvider("ElevenLabs").SetVoice("Charlotte"),
+			NewLanguage("es-ES").SetTranscriptionProvider("Google").SetSpeechModel("telephony"),
+		).
+		Parameter(NewParameter().SetName("customer").SetValue("C123"))))
+
+	want := header + `
+<Response>
+  <Connect action="https://example.com/connected">
+    <ConversationRelay url="wss://example.com/relay" welcomeGreeting="Hi! How can I help?" voice="en-US-Journey-O" language="en-US" ttsProvider="Google" transcriptionProvider="Deepgram">
+      <Language code="fr-FR" ttsProvider="ElevenLabs" voice="Charlotte"></Language>
+      <Language code="es-ES" transcriptionProvider="Google" speechModel="telephony"></Language>
+      <Parameter name="customer" value="C123"></Parameter>
+    </ConversationRelay>
+  </Connect>
+</Response>`
+
+	got, err := r.Render(ctx)
+	if err != nil {
+		t.Fatalf("Response.Render() error = %v", err)
+	}
+	if string(got) != want {
+		t.Errorf("Response.Render() = %v, want %v", string(got), want)
+	}
+	if err := r.Validate(); err != nil {
+		t.Errorf("Response.Validate() error = %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		relay *ConversationRelay
+	}{
+		{name: "No url", relay: NewConversationRelay()},
+		{name: "Not a WebSocket url", relay: NewConversationRelay().SetURL("https://example.com/relay")},
+		{name: "Language without code", relay: NewConversationRelay().SetURL("wss://example.com/relay").Languages(NewLanguage(""))},
+		{name: "Invalid child", relay: &ConversationRelay{URL: "wss://example.com/relay", Verbs: []Verb{NewSay("Hello")}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if err := NewResponse().Connect(NewConnect().ConversationRelay(tt.relay)).Validate(); err == nil {
+				t.Errorf("Response.Validate() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestResponse_WriteHTTP(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name            string
+		contentType     string
+		wantContentType string
+	}{
+		{name: "Default Content-Type", wantContentType: "text/xml; charset=utf-8"},
+		{name: "Content-Type already set", contentType: "application/xml", wantContentType: "application/xml"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := NewResponse().Say(NewSay("Hello")).Hangup()
+			want, err := r.Render(ctx)
+			if err != nil {
+				t.Fatalf("Response.Render() error = %v", err)
+			}
+			w := httptest.NewRecorder()
+			if tt.contentType != "" {
+				w.Header().Set("Content-Type", tt.contentType)
+			}
+			if err := r.WriteHTTP(ctx, w); err != nil {
+				t.Fatalf("Response.WriteHTTP() error = %v", err)
+			}
+			if got := w.Body.String(); got != string(want) {
+				t.Errorf("Response.WriteHTTP() = %v, want %v", got, string(want))
+			}
+			if got := w.Header().Get("Content-Length"); got != strconv.Itoa(len(want)) {
+				t.Errorf("Response.WriteHTTP() Content-Length = %v, want %d", got, len(want))
+			}
+			if got := w.Header().Get("Content-Type"); got != tt.wantContentType {
+				t.Errorf("Response.WriteHTTP() Content-Type = %v, want %v", got, tt.wantContentType)
+			}
+		})
+	}
+}
+
+func TestNumber_StatusCallback(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	header := xml.Header[:len(xml.Header)-1]
+
+	response := NewResponse().
+		Dial(NewDial().
+			Number(NewNumber("810-730-3842").
+				SetStatusCallbackEvent(DialCallbackEvents().Initiated().Answered()).
+				SetStatusCallback("https://example.com/leg1").
+				SetStatusCallbackMethod(Post)).
+			Number(NewNumber("810-730-3843").
+				SetStatusCallbackEvent(DialCallbackEvents().Ringing().Completed()).
+				SetStatusCallback("https://example.com/leg2").
+				SetStatusCallbackMethod(Get)))
+
+	want := header + `
+<Response>
+  <Dial>
+    <Number statusCallbackEvent="initiated answered" statusCallback="https://example.com/leg1" statusCallbackMethod="POST">810-730-3842</Number>
+    <Number statusCallbackEvent="ringing completed" statusCallback="https://example.com/leg2" statusCallbackMethod="GET">810-730-3843</Number>
+  </Dial>
+</Response>`
+
+	got, err := response.Render(ctx)
+	if err != nil {
+		t.Fatalf("Response.Render() error = %v", err)
+	}
+	if string(got) != want {
+		t.Errorf("Response.Render() = %v, want %v", string(got), want)
+	}
+}
+
+func TestNumber_MachineDetection(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	header := xml.Header[:len(xml.Header)-1]
+
+	response := NewResponse().
+		Dial(NewDial().
+			Number(NewNumber("810-730-3842").
+				SetMachineDetection(DetectMessageEndMachineDetection).
+				SetMachineDetectionTimeout(15).
+				SetMachineDetectionSpeechThreshold(2400).
+				SetMachineDetectionSpeechEndThreshold(1200).
+				SetMachineDetectionSilenceTimeout(4000).
+				SetAmdStatusCallback("https://example.com/amd").
+				SetAmdStatusCallbackMethod(Post)).
+			Number(NewNumber("810-730-3843").SetMachineDetection(EnableMachineDetection)))
+
+	want := header + `
+<Response>
+  <Dial>
+    <Number machineDetection="DetectMessageEnd" machineDetectionTimeout="15" machineDetectionSpeechThreshold="2400" machineDetectionSpeechEndThreshold="1200" machineDetectionSilenceTimeout="4000" amdStatusCallback="https://example.com/amd" amdStatusCallbackMethod="POST">810-730-3842</Number>
+    <Number machineDetection="Enable">810-730-3843</Number>
+  </Dial>
+</Response>`
+
+	got, err := response.Render(ctx)
+	if err != nil {
+		t.Fatalf("Response.Render() error = %v", err)
+	}
+	if string(got) != want {
+		t.Errorf("Response.Render() = %v, want %v", string(got), want)
+	}
+	if err := response.Validate(); err != nil {
+		t.Errorf("Response.Validate() error = %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		number *Number
+	}{
+		{name: "Unknown machineDetection", number: NewNumber("810-730-3842").SetMachineDetection("Detect")},
+		{name: "Timeout too short", number: NewNumber("810-730-3842").SetMachineDetectionTimeout(2)},
+		{name: "Speech threshold too long", number: NewNumber("810-730-3842").SetMachineDetectionSpeechThreshold(6001)},
+		{name: "Speech end threshold too short", number: NewNumber("810-730-3842").SetMachineDetectionSpeechEndThreshold(499)},
+		{name: "Silence timeout too long", number: NewNumber("810-730-3842").SetMachineDetectionSilenceTimeout(10001)},
+		{name: "Relative amdStatusCallback", number: NewNumber("810-730-3842").SetAmdStatusCallback("/amd")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if err := NewResponse().Dial(NewDial().Number(tt.number)).Validate(); err == nil {
+				t.Errorf("Response.Validate() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestDial_Numbers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	header := xml.Header[:len(xml.Header)-1]
+
+	response := NewResponse().
+		Dial(NewDial().
+			Numbers("810-730-3842", "+18005642365").
+			Number(NewNumber("810-730-3843")))
+
+	want := header + `
+<Response>
+  <Dial>
+    <Number>810-730-3842</Number>
+    <Number>+18005642365</Number>
+    <Number>810-730-3843</Number>
+  </Dial>
+</Response>`
+
+	got, err := response.Render(ctx)
+	if err != nil {
+		t.Fatalf("Response.Render() error = %v", err)
+	}
+	if string(got) != want {
+		t.Errorf("Response.Render() = %v, want %v", string(got), want)
+	}
+
+	if err := response.Validate(); err != nil {
+		t.Errorf("Response.Validate() error = %v", err)
+	}
+
+	if err := NewResponse().Dial(NewDial().Numbers("810-730-3842", "not a number")).Validate(); err == nil {
+		t.Errorf("Response.Validate() error = %v, wantErr %v", err, true)
+	}
+}
+
+func TestDial_Application(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	header := xml.Header[:len(xml.Header)-1]
+
+	response := NewResponse().
+		Dial(NewDial().
+			Application(NewApplication("AP1234567890abcdef1234567890abcd").
+				SetCustomerID("customer-1").
+				Parameter(NewParameter().SetName("AccountNumber").SetValue("12345"))))
+
+	want := header + `
+<Response>
+  <Dial>
+    <Application customerId="customer-1">
+      <ApplicationSid>AP1234567890abcdef1234567890abcd</ApplicationSid>
+      <Parameter name="AccountNumber" value="12345"></Parameter>
+    </Application>
+  </Dial>
+</Response>`
+
+	got, err := response.Render(ctx)
+	if err != nil {
+		t.Fatalf("Response.Render() error = %v", err)
+	}
+	if string(got) != want {
+		t.Errorf("Response.Render() = %v, want %v", string(got), want)
+	}
+
+	if err := response.Validate(); err != nil {
+		t.Errorf("Response.Validate() error = %v", err)
+	}
+
+	if err := NewResponse().Dial(NewDial().Application(NewApplication("CA1234567890abcdef1234567890abcd"))).Validate(); err == nil {
+		t.Errorf("Response.Validate() error = %v, wantErr %v", err, true)
+	}
+}
+
+func TestDial_Client(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	header := xml.Header[:len(xml.Header)-1]
+
+	tests := []struct {
+		name   string
+		client *Client
+		want   string
+	}{
+		{
+			name: "Identity with parameters",
+			client: NewClient().
+				Identity("alice").
+				Parameter(NewParameter().SetName("FirstName").SetValue("Alice")).
+				Parameter(NewParameter().SetName("LastName").SetValue("Smith")),
+			want: `<Client>
+      <Identity>alice</Identity>
+      <Parameter name="FirstName" value="Alice"></Parameter>
+      <Parameter name="LastName" value="Smith"></Parameter>
+    </Client>`,
+		},
+		{
+			name:   "Name",
+			client: NewClient().SetName("alice"),
+			want:   `<Client>alice</Client>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NewResponse().Dial(NewDial().Client(tt.client)).Render(ctx)
+			if err != nil {
+				t.Fatalf("Response.Render() error = %v", err)
+			}
+			want := header + `
+<Response>
+  <Dial>
+    ` + tt.want + `
+  </Dial>
+</Response>`
+			if string(got) != want {
+				t.Errorf("Response.Render() = %v, want %v", string(got), want)
+			}
+		})
+	}
+}
+
+func TestAddAttr(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	header := xml.Header[:len(xml.Header)-1]
+
+	response := NewResponse().
+		Say(NewSay("Hello").
+			SetVoice(AliceVoice).
+			AddAttr("language", "en-GB").
+			AddAttr("voice", "man").
+			AddAttr("language", "en-US")).
+		Dial(NewDial().
+			AddAttr("ringTone", "us").
+			Number(NewNumber("810-730-3842").AddAttr("sendDigits", "wwww1234")))
+
+	want := header + `
+<Response>
+  <Say voice="alice" language="en-US">Hello</Say>
+  <Dial ringTone="us">
+    <Number sendDigits="wwww1234">810-730-3842</Number>
+  </Dial>
+</Response>`
+
+	got, err := response.Render(ctx)
+	if err != nil {
+		t.Fatalf("Response.Render() error = %v", err)
+	}
+	if string(got) != want {
+		t.Errorf("Response.Render() = %v, want %v", string(got), want)
+	}
+}
+
+func TestResponse_RemoveVerb(t *testing.T) {
+	t.Parallel()
+
+	build := func() *Response {
+		return NewResponse().
+			Gather(NewGather().SetAction("/gather").Say(NewSay("Say sales")).Pause(1)).
+			Say(NewSay("Goodbye")).
+			Hangup()
+	}
+
+	tests := []struct {
+		name string
+		edit func(r *Response)
+		want *Response
+	}{
+		{name: "Remove first", edit: func(r *Response) { r.RemoveVerb(0) }, want: NewResponse().Say(NewSay("Goodbye")).Hangup()},
+		{name: "Remove last", edit: func(r *Response) { r.RemoveVerb(2) }, want: NewResponse().Gather(NewGather().SetAction("/gather").Say(NewSay("Say sales")).Pause(1)).Say(NewSay("Goodbye"))},
+		{name: "Negative index", edit: func(r *Response) { r.RemoveVerb(-1) }, want: build()},
+		{name: "Index out of range", edit: func(r *Response) { r.RemoveVerb(3) }, want: build()},
+		{name: "Clear", edit: func(r *Response) { r.Clear() }, want: NewResponse()},
+		{
+			name: "Remove from Gather",
+			edit: func(r *Response) {
+				gather, _ := r.Verbs[0].(*Gather)
+				gather.RemoveVerb(0).RemoveVerb(5)
+			},
+			want: NewResponse().Gather(NewGather().SetAction("/gather").Pause(1)).Say(NewSay("Goodbye")).Hangup(),
+		},
+		{
+			name: "Clear Gather",
+			edit: func(r *Response) {
+				gather, _ := r.Verbs[0].(*Gather)
+				gather.Clear()
+			},
+			want: NewResponse().Gather(NewGather().SetAction("/gather")).Say(NewSay("Goodbye")).Hangup(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := build()
+			tt.edit(r)
+			if d := r.Diff(tt.want); d != "" {
+				t.Errorf("Response.RemoveVerb() %s", d)
+			}
+		})
+	}
+}
+
+func TestResponse_AppendResponse(t *testing.T) {
+	t.Parallel()
+
+	greeting := NewResponse().Say(NewSay("Thanks for calling"))
+	menu := NewResponse().
+		Gather(NewGather().SetAction("/menu").Say(NewSay("Press 1 for sales"))).
+		Redirect(NewRedirect("/menu"))
+
+	r := NewResponse().AppendResponse(greeting).AppendResponse(menu).AppendResponse(nil)
+
+	// Changes to the parts after they are appended must not leak into the composed Response
+	menu.Hangup()
+	gather, _ := menu.Verbs[0].(*Gather)
+	gather.SetAction("/other").Pause(1)
+
+	want := NewResponse().
+		Say(NewSay("Thanks for calling")).
+		Gather(NewGather().SetAction("/menu").Say(NewSay("Press 1 for sales"))).
+		Redirect(NewRedirect("/menu"))
+	if d := r.Diff(want); d != "" {
+		t.Errorf("Response.AppendResponse() %s", d)
+	}
+}
+
+func TestNewResponse_Defaults(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		response *Response
+		want     *Response
+	}{
+		{
+			name:     "No defaults",
+			response: NewResponse().Say(NewSay("Hello")).Redirect(NewRedirect("/next")),
+			want:     &Response{Verbs: []Verb{&Say{Value: "Hello"}, &Redirect{Value: "/next"}}},
+		},
+		{
+			name:     "Default voice",
+			response: NewResponse(WithDefaultVoice(AliceVoice)).Say(NewSay("Hello")).Gather(NewGather().SetAction("/gather").Say(NewSay("Press 1"))),
+			want:     &Response{Verbs: []Verb{&Say{Voice: AliceVoice, Value: "Hello"}, &Gather{Action: "/gather", Verbs: []Verb{&Say{Voice: AliceVoice, Value: "Press 1"}}}}},
+		},
+		{
+			name:     "Voice set on the Say",
+			response: NewResponse(WithDefaultVoice(AliceVoice)).Say(PollyMatthew.Say("Hello")),
+			want:     &Response{Verbs: []Verb{&Say{Voice: PollyMatthew, Value: "Hello"}}},
+		},
+		{
+			name: "Default method",
+			response: NewResponse(WithDefaultMethod(Get)).
+				Dial(NewDial().SetAction("/dial").Number(NewNumber("+18005642365").SetStatusCallback("https://example.com/status"))).
+				Redirect(NewRedirect("/next")),
+			want: &Response{Verbs: []Verb{
+				&Dial{Action: "/dial", Method: Get, Verbs: []Verb{&Number{Value: "+18005642365", StatusCallback: "https://example.com/status", StatusCallbackMethod: Get}}},
+				&Redirect{Value: "/next", Method: Get},
+			}},
+		},
+		{
+			name:     "Method set on the verb",
+			response: NewResponse(WithDefaultMethod(Get)).Redirect(NewRedirect("/next").SetMethod(Post)).Record(NewRecord()),
+			want:     &Response{Verbs: []Verb{&Redirect{Value: "/next", Method: Post}, &Record{}}},
+		},
+		{
+			name:     "Appended Response",
+			response: NewResponse(WithDefaultVoice(AliceVoice)).AppendResponse(NewResponse().Say(NewSay("Hello"))),
+			want:     &Response{Verbs: []Verb{&Say{Voice: AliceVoice, Value: "Hello"}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if d := tt.response.Diff(tt.want); d != "" {
+				t.Errorf("NewResponse() %s", d)
+			}
+		})
+	}
+}
+
+func TestParameter_SetEncodedValue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		parameter *Parameter
+		want      string
+	}{
+		{name: "Raw spaces", parameter: NewParameter().SetName("q").SetValue("hold music"), want: `<Parameter name="q" value="hold music"></Parameter>`},
+		{name: "Raw ampersand", parameter: NewParameter().SetName("q").SetValue("sales&support"), want: `<Parameter name="q" value="sales&amp;support"></Parameter>`},
+		{name: "Encoded spaces", parameter: NewParameter().SetName("q").SetEncodedValue("hold music"), want: `<Parameter name="q" value="hold+music"></Parameter>`},
+		{name: "Encoded ampersand", parameter: NewParameter().SetName("q").SetEncodedValue("sales&support=1"), want: `<Parameter name="q" value="sales%26support%3D1"></Parameter>`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tt.parameter.String(); got != tt.want {
+				t.Errorf("Parameter.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGather_SetInputModes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		modes []InputType
+		want  string
+	}{
+		{name: "None", modes: nil, want: ""},
+		{name: "DTMF", modes: []InputType{DTMFInput}, want: "dtmf"},
+		{name: "DTMF then speech", modes: []InputType{DTMFInput, SpeechInput}, want: "dtmf speech"},
+		{name: "Speech then DTMF", modes: []InputType{SpeechInput, DTMFInput}, want: "speech dtmf"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := NewGather().SetInputModes(tt.modes...).Input; got != tt.want {
+				t.Errorf("Gather.SetInputModes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGather_SetTimeoutDuration(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		timeout time.Duration
+		want    uint
+	}{
+		{name: "Seconds", timeout: 10 * time.Second, want: 10},
+		{name: "Round down", timeout: 10*time.Second + 400*time.Millisecond, want: 10},
+		{name: "Round up", timeout: 10*time.Second + 500*time.Millisecond, want: 11},
+		{name: "Milliseconds", timeout: 5 * time.Millisecond, want: 1},
+		{name: "Zero", timeout: 0, want: 0},
+		{name: "Negative", timeout: -time.Second, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := NewGather().SetTimeoutDuration(tt.timeout).Timeout; got != tt.want {
+				t.Errorf("Gather.SetTimeoutDuration() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRedirect_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		redirect *Redirect
+		wantErr  bool
+	}{
+		{name: "Absolute", redirect: NewRedirect("https://example.com/voice?step=menu"), wantErr: false},
+		{name: "Relative", redirect: NewRedirect("/voice"), wantErr: false},
+		{name: "Empty", redirect: NewRedirect(""), wantErr: true},
+		{name: "Malformed", redirect: NewRedirect("https://example.com/%zz"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if err := tt.redirect.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Redirect.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err := NewResponse().Redirect(tt.redirect).Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Response.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package twiml
+
+import "fmt"
+
+// SpeechModelType is an enum for the Gather speech recognition models
+type SpeechModelType string
+
+const (
+	// DefaultSpeechModel is suited to most speech input
+	DefaultSpeechModel SpeechModelType = "default"
+
+	// NumbersAndCommandsSpeechModel is tuned for short utterances, such as digits and menu commands
+	NumbersAndCommandsSpeechModel SpeechModelType = "numbers_and_commands"
+
+	// PhoneCallSpeechModel is tuned for audio from phone calls
+	PhoneCallSpeechModel SpeechModelType = "phone_call"
+
+	// ExperimentalConversationsSpeechModel is tuned for spontaneous, conversational speech
+	ExperimentalConversationsSpeechModel SpeechModelType = "experimental_conversations"
+
+	// ExperimentalUtterancesSpeechModel is tuned for short, command-like speech
+	ExperimentalUtterancesSpeechModel SpeechModelType = "experimental_utterances"
+)
+
+// speechModelLanguages lists the languages each speech model supports. Models which are
+// missing, including those Twilio has added since, are not checked.
+var speechModelLanguages = map[SpeechModelType][]string{
+	NumbersAndCommandsSpeechModel:        {"en-AU", "en-GB", "en-IN", "en-US", "es-ES", "es-US", "fr-CA", "fr-FR", "ja-JP", "pt-BR", "ru-RU"},
+	PhoneCallSpeechModel:                 {"en-AU", "en-GB", "en-IN", "en-US", "es-ES", "es-US", "fr-CA", "fr-FR", "ja-JP", "pt-BR", "ru-RU"},
+	ExperimentalConversationsSpeechModel: {"en-US"},
+	ExperimentalUtterancesSpeechModel:    {"en-US"},
+}
+
+// validateSpeechModel checks that model supports language, where an unset language is
+// Twilio's default of en-US
+func validateSpeechModel(model SpeechModelType, language string) error {
+	languages, ok := speechModelLanguages[model]
+	if !ok {
+		return nil
+	}
+
+	if language == "" {
+		language = "en-US"
+	}
+	for _, l := range languages {
+		if l == language {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("speechModel %q does not support language %q", model, language)
+}
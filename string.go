@@ -0,0 +1,137 @@
+package twiml
+
+import (
+	"encoding/xml"
+	"fmt"
+)
+
+// verbString returns v rendered as an XML fragment, for test output and logs. Unlike Render,
+// an error is not returned, but described in the returned string.
+func verbString(v interface{}) string {
+	b, err := xml.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("<%s !ERROR: %v>", verbName(v), err)
+	}
+
+	return string(b)
+}
+
+// String returns the Dial rendered as an XML fragment
+func (d *Dial) String() string {
+	return verbString(d)
+}
+
+// String returns the Say rendered as an XML fragment
+func (s *Say) String() string {
+	return verbString(s)
+}
+
+// String returns the Number rendered as an XML fragment
+func (n *Number) String() string {
+	return verbString(n)
+}
+
+// String returns the Gather rendered as an XML fragment
+func (g *Gather) String() string {
+	return verbString(g)
+}
+
+// String returns the Pause rendered as an XML fragment
+func (p *Pause) String() string {
+	return verbString(p)
+}
+
+// String returns the Redirect rendered as an XML fragment
+func (r *Redirect) String() string {
+	return verbString(r)
+}
+
+// String returns the Record rendered as an XML fragment
+func (r *Record) String() string {
+	return verbString(r)
+}
+
+// String returns the Enqueue rendered as an XML fragment
+func (e *Enqueue) String() string {
+	return verbString(e)
+}
+
+// String returns the Reject rendered as an XML fragment
+func (r *Reject) String() string {
+	return verbString(r)
+}
+
+// String returns the Conference rendered as an XML fragment
+func (c *Conference) String() string {
+	return verbString(c)
+}
+
+// String returns the Play rendered as an XML fragment
+func (p *Play) String() string {
+	return verbString(p)
+}
+
+// String returns the Start rendered as an XML fragment
+func (s *Start) String() string {
+	return verbString(s)
+}
+
+// String returns the Connect rendered as an XML fragment
+func (c *Connect) String() string {
+	return verbString(c)
+}
+
+// String returns the Stream rendered as an XML fragment
+func (s *Stream) String() string {
+	return verbString(s)
+}
+
+// String returns the Parameter rendered as an XML fragment
+func (p *Parameter) String() string {
+	return verbString(p)
+}
+
+// String returns the Application rendered as an XML fragment
+func (a *Application) String() string {
+	return verbString(a)
+}
+
+// String returns the Client rendered as an XML fragment
+func (c *Client) String() string {
+	return verbString(c)
+}
+
+// String returns the Identity rendered as an XML fragment
+func (i *Identity) String() string {
+	return verbString(i)
+}
+
+// String returns the Sip rendered as an XML fragment
+func (s *Sip) String() string {
+	return verbString(s)
+}
+
+// String returns the Refer rendered as an XML fragment
+func (r *Refer) String() string {
+	return verbString(r)
+}
+
+// String returns the ConversationRelay rendered as an XML fragment
+func (c *ConversationRelay) String() string {
+	return verbString(c)
+}
+
+// String returns the Language rendered as an XML fragment
+func (l *Language) String() string {
+	return verbString(l)
+}
+
+// String returns the Hangup rendered as an XML fragment
+func (h *Hangup) String() string {
+	return verbString(h)
+}
+
+// String returns the RawXML as written
+func (r RawXML) String() string {
+	return string(r)
+}
@@ -0,0 +1,43 @@
+package twiml
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestVerb_String(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		verb fmt.Stringer
+		want string
+	}{
+		{name: "Say", verb: NewSay("Hello").SetVoice(AliceVoice), want: `<Say voice="alice">Hello</Say>`},
+		{name: "Play", verb: NewPlay("https://example.com/hold.mp3").SetLoop(2), want: `<Play loop="2">https://example.com/hold.mp3</Play>`},
+		{name: "Pause", verb: NewPause(2), want: `<Pause length="2"></Pause>`},
+		{name: "Redirect", verb: NewRedirect("/next").SetMethod(Post), want: `<Redirect method="POST">/next</Redirect>`},
+		{name: "Hangup", verb: &Hangup{}, want: `<Hangup></Hangup>`},
+		{name: "Dial", verb: NewDial().SetTimeout(10).Number(NewNumber("+18005642365")), want: `<Dial timeout="10"><Number>+18005642365</Number></Dial>`},
+		{name: "Gather", verb: NewGather().SetAction("/gather").Say(NewSay("Press 1")), want: `<Gather action="/gather"><Say>Press 1</Say></Gather>`},
+		{name: "Raw", verb: RawXML(`<Hangup/>`), want: `<Hangup/>`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tt.verb.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVerb_StringError(t *testing.T) {
+	t.Parallel()
+
+	got := NewSay("").SetSSML(`Hello <emphasis>world`).String()
+	if !strings.HasPrefix(got, "<Say !ERROR: ") {
+		t.Errorf("Say.String() = %v, want an error placeholder", got)
+	}
+}
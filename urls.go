@@ -0,0 +1,200 @@
+package twiml
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/go-playground/errors/v5"
+)
+
+// ResolveURLs resolves the relative URLs of every verb in the Response against base, so
+// that action, callback, waitUrl and Redirect URLs can be built relative to the webhook
+// host. URLs which are already absolute are left untouched.
+func (r *Response) ResolveURLs(base *url.URL) error {
+	var errs []error
+	walkVerbs(r.Verbs, func(v Verb) {
+		for _, attr := range urlAttrs(v) {
+			if *attr.value == "" {
+				continue
+			}
+
+			u, err := url.Parse(*attr.value)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("twiml.Response.ResolveURLs(): %s %q: %w", attr.name, *attr.value, err))
+
+				continue
+			}
+			if u.IsAbs() {
+				continue
+			}
+			*attr.value = base.ResolveReference(u).String()
+		}
+	})
+
+	return errors.Join(errs...)
+}
+
+// urlAttr is a URL bearing attribute of a verb, with the attribute of its HTTP method if it
+// has one. A callback is a URL Twilio notifies, as opposed to one it requests the next TwiML from.
+type urlAttr struct {
+	name     string
+	value    *string
+	method   *MethodType
+	callback bool
+}
+
+// urlAttrs returns the URL bearing attributes of v
+func urlAttrs(v Verb) []urlAttr {
+	switch v := v.(type) {
+	case *Dial:
+		return []urlAttr{
+			{name: "action", value: &v.Action, method: &v.Method},
+			{name: "recordingStatusCallback", value: &v.RecordingStatusCallback, method: &v.RecordingStatusCallbackMethod, callback: true},
+		}
+	case *Gather:
+		return []urlAttr{
+			{name: "action", value: &v.Action, method: &v.Method},
+			{name: "partialResultCallback", value: &v.PartialResultCallback, method: &v.PartialResultCallbackMethod, callback: true},
+		}
+	case *Number:
+		return []urlAttr{
+			{name: "url", value: &v.URL, method: &v.Method},
+			{name: "statusCallback", value: &v.StatusCallback, method: &v.StatusCallbackMethod, callback: true},
+			{name: "amdStatusCallback", value: &v.AmdStatusCallback, method: &v.AmdStatusCallbackMethod, callback: true},
+		}
+	case *Record:
+		return []urlAttr{
+			{name: "action", value: &v.Action, method: &v.Method},
+			{name: "recordingStatusCallback", value: &v.RecordingStatusCallback, method: &v.RecordingStatusCallbackMethod, callback: true},
+			{name: "transcribeCallback", value: &v.TranscribeCallback, callback: true},
+		}
+	case *Enqueue:
+		return []urlAttr{
+			{name: "action", value: &v.Action, method: &v.Method},
+			{name: "waitUrl", value: &v.WaitURL, method: &v.WaitURLMethod},
+		}
+	case *Redirect:
+		return []urlAttr{
+			{name: "Redirect", value: &v.Value, method: &v.Method},
+		}
+	case *Conference:
+		// A nil waitUrl is Twilio's default hold music, which has no URL to resolve
+		waitURL := v.WaitURL
+		if waitURL == nil {
+			waitURL = new(string)
+		}
+
+		return []urlAttr{
+			{name: "statusCallback", value: &v.StatusCallback, method: &v.StatusCallbackMethod, callback: true},
+			{name: "recordingStatusCallback", value: &v.RecordingStatusCallback, method: &v.RecordingStatusCallbackMethod, callback: true},
+			{name: "eventCallbackUrl", value: &v.EventCallbackURL, callback: true},
+			{name: "waitUrl", value: waitURL, method: &v.WaitMethod},
+		}
+	case *Connect:
+		return []urlAttr{
+			{name: "action", value: &v.Action, method: &v.Method},
+		}
+	case *Sip:
+		return []urlAttr{
+			{name: "url", value: &v.URL, method: &v.Method},
+			{name: "statusCallback", value: &v.StatusCallback, method: &v.StatusCallbackMethod, callback: true},
+		}
+	case *Refer:
+		return []urlAttr{
+			{name: "action", value: &v.Action, method: &v.Method},
+		}
+	case *Stream:
+		return []urlAttr{
+			{name: "statusCallback", value: &v.StatusCallback, method: &v.StatusCallbackMethod, callback: true},
+		}
+	}
+
+	return nil
+}
+
+// validateMethods checks that each method attribute of v is exactly GET or POST, as Twilio
+// is case sensitive
+func validateMethods(v Verb) []error {
+	var errs []error
+	for _, attr := range urlAttrs(v) {
+		if attr.method != nil && *attr.method != "" && *attr.method != Get && *attr.method != Post {
+			errs = append(errs, fmt.Errorf("twiml.%s.Validate(): method %q for %s must be GET or POST", verbName(v), *attr.method, attr.name))
+		}
+	}
+
+	return errs
+}
+
+// methodWarnings warns about each callback of v which is set without its method, since the
+// default of POST is easy to overlook when debugging a callback
+func methodWarnings(v Verb) []string {
+	var warnings []string
+	for _, attr := range urlAttrs(v) {
+		if attr.callback && attr.method != nil && *attr.value != "" && *attr.method == "" {
+			warnings = append(warnings, fmt.Sprintf("twiml.%s.Warnings(): %s is set without a method, so Twilio defaults to POST", verbName(v), attr.name))
+		}
+	}
+
+	return warnings
+}
+
+// validateCallbackURLs checks that each callback of v is an absolute URL, as Twilio does not
+// resolve callbacks against the webhook URL the way it does action and Redirect URLs
+func validateCallbackURLs(v Verb) []error {
+	var errs []error
+	for _, attr := range urlAttrs(v) {
+		if !attr.callback || *attr.value == "" {
+			continue
+		}
+
+		u, err := url.Parse(*attr.value)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("twiml.%s.Validate(): %s %q: %w", verbName(v), attr.name, *attr.value, err))
+
+			continue
+		}
+		if !u.IsAbs() {
+			errs = append(errs, fmt.Errorf("twiml.%s.Validate(): %s %q must be an absolute URL", verbName(v), attr.name, *attr.value))
+		}
+	}
+
+	return errs
+}
+
+// originWarnings warns about each absolute action or Redirect URL whose origin differs from
+// the first absolute one in verbs. Twilio fetches the next TwiML from these, so they normally
+// stay on the webhook host, and a stray origin is usually a leftover from another environment.
+func originWarnings(verbs []Verb) []string {
+	var (
+		origin   string
+		warnings []string
+	)
+	walkVerbs(verbs, func(v Verb) {
+		for _, attr := range urlAttrs(v) {
+			if attr.callback || *attr.value == "" {
+				continue
+			}
+
+			u, err := url.Parse(*attr.value)
+			if err != nil || !u.IsAbs() {
+				continue
+			}
+			switch o := u.Scheme + "://" + u.Host; {
+			case origin == "":
+				origin = o
+			case o != origin:
+				warnings = append(warnings, fmt.Sprintf("twiml.%s.Warnings(): %s %q is on a different origin than %s", verbName(v), attr.name, *attr.value, origin))
+			}
+		}
+	})
+
+	return warnings
+}
+
+// walkVerbs calls fn for each verb, and the verbs nested within it, in document order
+func walkVerbs(verbs []Verb, fn func(v Verb)) {
+	for _, v := range verbs {
+		fn(v)
+		walkVerbs(nestedVerbs(v), fn)
+	}
+}
@@ -0,0 +1,183 @@
+package twiml
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestResponse_ResolveURLs(t *testing.T) {
+	t.Parallel()
+
+	base, err := url.Parse("https://example.com/voice/")
+	if err != nil {
+		t.Fatalf("url.Parse() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		response *Response
+		want     *Response
+		wantErr  bool
+	}{
+		{
+			name: "Relative",
+			response: NewResponse().
+				Gather(NewGather().SetAction("gather?step=menu").Say(NewSay("Enter your pin"))).
+				Dial(NewDial().Conference(NewConference("room").SetWaitURL("/wait").SetStatusCallback("status"))).
+				Redirect(NewRedirect("../start")),
+			want: NewResponse().
+				Gather(NewGather().SetAction("https://example.com/voice/gather?step=menu").Say(NewSay("Enter your pin"))).
+				Dial(NewDial().Conference(NewConference("room").SetWaitURL("https://example.com/wait").SetStatusCallback("https://example.com/voice/status"))).
+				Redirect(NewRedirect("https://example.com/start")),
+		},
+		{
+			name: "Absolute",
+			response: NewResponse().
+				Gather(NewGather().SetAction("https://other.example.com/gather").Say(NewSay("Enter your pin"))).
+				Redirect(NewRedirect("https://other.example.com/start")),
+			want: NewResponse().
+				Gather(NewGather().SetAction("https://other.example.com/gather").Say(NewSay("Enter your pin"))).
+				Redirect(NewRedirect("https://other.example.com/start")),
+		},
+		{
+			name:     "Relative whisper",
+			response: NewResponse().Dial(NewDial().Number(NewNumber("+18005642365").SetURL("whisper?agent=42").SetMethod(Get))),
+			want:     NewResponse().Dial(NewDial().Number(NewNumber("+18005642365").SetURL("https://example.com/voice/whisper?agent=42").SetMethod(Get))),
+		},
+		{
+			name:     "Unset",
+			response: NewResponse().Dial(NewDial().Conference(NewConference("room").DisableWaitURL())),
+			want:     NewResponse().Dial(NewDial().Conference(NewConference("room").DisableWaitURL())),
+		},
+		{
+			name:     "Malformed",
+			response: NewResponse().Redirect(NewRedirect("/start/%zz")),
+			want:     NewResponse().Redirect(NewRedirect("/start/%zz")),
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if err := tt.response.ResolveURLs(base); (err != nil) != tt.wantErr {
+				t.Errorf("Response.ResolveURLs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if d := tt.response.Diff(tt.want); d != "" {
+				t.Errorf("Response.ResolveURLs() %s", d)
+			}
+		})
+	}
+}
+
+func TestResponse_ValidateMethods(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		response     *Response
+		wantErr      bool
+		wantWarnings int
+	}{
+		{name: "Explicit methods", response: NewResponse().Dial(NewDial().SetAction("/dial").SetMethod(Get).Number(NewNumber("+18005642365").SetStatusCallback("https://example.com/status").SetStatusCallbackMethod(Post)))},
+		{name: "Action without method", response: NewResponse().Redirect(NewRedirect("/next"))},
+		{name: "Callback without method", response: NewResponse().Dial(NewDial().Number(NewNumber("+18005642365").SetStatusCallback("https://example.com/status"))), wantWarnings: 1},
+		{name: "Wait URL without method", response: NewResponse().Dial(NewDial().Conference(NewConference("room").SetWaitURL("https://example.com/wait")))},
+		{name: "Lowercase method", response: NewResponse().Redirect(NewRedirect("/next").SetMethod("post")), wantErr: true},
+		{name: "Lowercase callback method", response: NewResponse().Connect(NewConnect().Stream(NewStream().SetURL("wss://example.com").SetStatusCallback("https://example.com/status").SetStatusCallbackMethod("get"))), wantErr: true},
+		{name: "Method without URL", response: NewResponse().Dial(NewDial().Conference(&Conference{Value: "room", WaitMethod: "Post"})), wantErr: true},
+		{name: "Gather GET", response: NewResponse().Gather(NewGather().SetAction("/gather").SetMethod(Get))},
+		{name: "Gather lowercase method", response: NewResponse().Gather(NewGather().SetAction("/gather").SetMethod("get")), wantErr: true},
+		{name: "Gather invalid method", response: NewResponse().Gather(NewGather().SetAction("/gather").SetMethod("PUT")), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if err := tt.response.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Response.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := tt.response.Warnings(); len(got) != tt.wantWarnings {
+				t.Errorf("Response.Warnings() = %v, want %d warnings", got, tt.wantWarnings)
+			}
+		})
+	}
+
+	err := NewResponse().Gather(NewGather().SetAction("/gather").SetMethod("get")).Validate()
+	if n := strings.Count(fmt.Sprint(err), "must be GET or POST"); n != 1 {
+		t.Errorf("Response.Validate() = %v, want the Gather method reported once", err)
+	}
+}
+
+func TestResponse_ValidateURLs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		response     *Response
+		wantErr      bool
+		wantWarnings int
+	}{
+		{name: "Absolute callback", response: NewResponse().Record(NewRecord().SetRecordingStatusCallback("https://example.com/recording").SetRecordingStatusCallbackMethod(Post))},
+		{name: "Relative callback", response: NewResponse().Record(NewRecord().SetRecordingStatusCallback("/recording").SetRecordingStatusCallbackMethod(Post)), wantErr: true},
+		{name: "Relative callback without method", response: NewResponse().Record(NewRecord().SetTranscribeCallback("/transcription")), wantErr: true},
+		{name: "Relative Conference wait URL", response: NewResponse().Dial(NewDial().Conference(NewConference("room").SetWaitURL("/wait").SetWaitMethod(Get)))},
+		{name: "Relative Enqueue wait URL", response: NewResponse().Enqueue(NewEnqueue("support").SetWaitURL("/wait").SetWaitURLMethod(Get))},
+		{name: "Cross origin wait URL", response: NewResponse().Dial(NewDial().SetAction("https://example.com/dial").Conference(NewConference("room").SetWaitURL("https://twimlets.example.net/holdmusic"))), wantWarnings: 1},
+		{name: "Malformed callback", response: NewResponse().Dial(NewDial().Number(NewNumber("+18005642365").SetStatusCallback("https://example.com/%zz").SetStatusCallbackMethod(Post))), wantErr: true},
+		{name: "Relative whisper", response: NewResponse().Dial(NewDial().Number(NewNumber("+18005642365").SetURL("/whisper").SetMethod(Post)))},
+		{name: "Malformed whisper", response: NewResponse().Dial(NewDial().Number(NewNumber("+18005642365").SetURL("/whisper/%zz").SetMethod(Post))), wantErr: true},
+		{name: "Relative action", response: NewResponse().Gather(NewGather().SetAction("/gather")).Redirect(NewRedirect("/start"))},
+		{name: "Same origin action", response: NewResponse().Gather(NewGather().SetAction("https://example.com/gather")).Redirect(NewRedirect("https://example.com/start"))},
+		{name: "Cross origin action", response: NewResponse().Gather(NewGather().SetAction("https://example.com/gather")).Redirect(NewRedirect("https://staging.example.com/start")), wantWarnings: 1},
+		{name: "Cross origin callback", response: NewResponse().Dial(NewDial().SetAction("https://example.com/dial").Number(NewNumber("+18005642365").SetStatusCallback("https://status.example.net/").SetStatusCallbackMethod(Post)))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if err := tt.response.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Response.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := tt.response.Warnings(); len(got) != tt.wantWarnings {
+				t.Errorf("Response.Warnings() = %v, want %d warnings", got, tt.wantWarnings)
+			}
+		})
+	}
+}
+
+func TestMethodType_MarshalXMLAttr(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	header := xml.Header[:len(xml.Header)-1]
+
+	tests := []struct {
+		name   string
+		method MethodType
+		want   string
+	}{
+		{name: "Unset", method: "", want: `<Redirect>/next</Redirect>`},
+		{name: "POST", method: Post, want: `<Redirect method="POST">/next</Redirect>`},
+		{name: "Lowercase post", method: "post", want: `<Redirect method="POST">/next</Redirect>`},
+		{name: "Mixed case get", method: "Get", want: `<Redirect method="GET">/next</Redirect>`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NewResponse().Redirect(&Redirect{Method: tt.method, Value: "/next"}).Render(ctx)
+			if err != nil {
+				t.Fatalf("Response.Render() error = %v", err)
+			}
+			want := header + `
+<Response>
+  ` + tt.want + `
+</Response>`
+			if string(got) != want {
+				t.Errorf("Response.Render() = %v, want %v", string(got), want)
+			}
+		})
+	}
+}
@@ -1,8 +1,10 @@
 package twiml
 
 import (
+	"encoding/xml"
 	"errors"
 	"fmt"
+	"io"
 	"net/url"
 	"strings"
 
@@ -94,10 +96,46 @@ func validateKeyPadEntry(v interface{}, param string) error {
 	}
 }
 
+// validateCharset returns a validator which checks that a value only contains the allowed
+// characters. param of "allowempty" will allow an empty value
+func validateCharset(allowed string) func(v interface{}, param string) error {
+	return func(v interface{}, param string) error {
+		switch s := v.(type) {
+		case string:
+			if s == "" {
+				if param == allowempty {
+					return nil
+				}
+
+				return errors.New("Required")
+			}
+
+			return characterList(s, allowed)
+		case *string:
+			if s == nil {
+				if param == allowempty {
+					return nil
+				}
+
+				return errors.New("Required")
+			}
+
+			return characterList(*s, allowed)
+		default:
+			return fmt.Errorf("validateCharset: Unexpected type %T", s)
+		}
+	}
+}
+
 func validateNumericPoundStar(v string) error {
 	return characterList(v, "0123456789#*")
 }
 
+// validateDTMFDigits checks for DTMF tones, where w represents a half second pause
+func validateDTMFDigits(v string) error {
+	return characterList(v, "0123456789#*w")
+}
+
 // characterList checks a string against a list of acceptable characters.
 // returns an erro if a character is found which is not in charList
 func characterList(s, charList string) error {
@@ -110,6 +148,19 @@ func characterList(s, charList string) error {
 	return nil
 }
 
+// validateURL checks that an absolute or relative URL is provided
+func validateURL(v string) error {
+	if v == "" {
+		return errors.New("Required")
+	}
+
+	if _, err := url.Parse(v); err != nil {
+		return errors.New("invalid URL")
+	}
+
+	return nil
+}
+
 // validSIPURI checks that a valid sip uri is provided
 // param of "allowempty" will allow a nil value
 func validSIPURI(v interface{}, param string) error {
@@ -177,3 +228,45 @@ func parseSIPURI(uri string) (*url.URL, error) {
 
 	return u, nil
 }
+
+// validateSSML checks that v is well-formed XML content, which may be a mix of text and
+// elements, as the content of a Say. Content which closes the Say early is rejected.
+func validateSSML(v string) error {
+	dec := xml.NewDecoder(strings.NewReader("<speak>" + v + "</speak>"))
+	depth := 0
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("invalid SSML: %w", err)
+		}
+
+		switch tok.(type) {
+		case xml.StartElement:
+			if depth == 0 && dec.InputOffset() > int64(len("<speak>")) {
+				return errors.New("invalid SSML: closes the Say element")
+			}
+			depth++
+		case xml.EndElement:
+			depth--
+		}
+	}
+}
+
+// ssmlHasEmptyProsody reports whether the SSML has a prosody element without any attributes,
+// which Twilio ignores. SSML which is not well-formed is reported by validateSSML instead.
+func ssmlHasEmptyProsody(ssml string) bool {
+	dec := xml.NewDecoder(strings.NewReader("<speak>" + ssml + "</speak>"))
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return false
+		}
+
+		if start, ok := tok.(xml.StartElement); ok && start.Name.Local == "prosody" && len(start.Attr) == 0 {
+			return true
+		}
+	}
+}
@@ -30,3 +30,37 @@ func Test_validSipURI(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateCharset(t *testing.T) {
+	t.Parallel()
+
+	digits := "digits"
+
+	type args struct {
+		v     interface{}
+		param string
+	}
+	tests := []struct {
+		name    string
+		allowed string
+		args    args
+		wantErr bool
+	}{
+		{name: "Digits only", allowed: "0123456789", args: args{v: "1234"}, wantErr: false},
+		{name: "Pound not allowed", allowed: "0123456789", args: args{v: "1234#"}, wantErr: true},
+		{name: "Extra characters", allowed: "0123456789#*ABCD", args: args{v: "12AD#"}, wantErr: false},
+		{name: "Pointer", allowed: "dgits", args: args{v: &digits}, wantErr: false},
+		{name: "Nil pointer", allowed: "0123456789", args: args{v: (*string)(nil)}, wantErr: true},
+		{name: "Allow empty", allowed: "0123456789", args: args{v: "", param: "allowempty"}, wantErr: false},
+		{name: "Empty", allowed: "0123456789", args: args{v: ""}, wantErr: true},
+		{name: "Unexpected type", allowed: "0123456789", args: args{v: 1234}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if err := validateCharset(tt.allowed)(tt.args.v, tt.args.param); (err != nil) != tt.wantErr {
+				t.Errorf("validateCharset() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package twiml
+
+// Verb is implemented by the TwiML verbs and nouns, and by RawXML, which are the only values
+// which can be nested within a Response or another verb
+type Verb interface {
+	isVerb()
+}
+
+func (*Dial) isVerb() {}
+
+func (*Say) isVerb() {}
+
+func (*Number) isVerb() {}
+
+func (*Gather) isVerb() {}
+
+func (*Pause) isVerb() {}
+
+func (*Redirect) isVerb() {}
+
+func (*Record) isVerb() {}
+
+func (*Enqueue) isVerb() {}
+
+func (*Reject) isVerb() {}
+
+func (*Conference) isVerb() {}
+
+func (*Play) isVerb() {}
+
+func (*Start) isVerb() {}
+
+func (*Connect) isVerb() {}
+
+func (*Stream) isVerb() {}
+
+func (*Parameter) isVerb() {}
+
+func (*Application) isVerb() {}
+
+func (*Client) isVerb() {}
+
+func (*Identity) isVerb() {}
+
+func (*Sip) isVerb() {}
+
+func (*Refer) isVerb() {}
+
+func (*ConversationRelay) isVerb() {}
+
+func (*Language) isVerb() {}
+
+func (*Hangup) isVerb() {}
+
+func (RawXML) isVerb() {}